@@ -0,0 +1,106 @@
+// Package alerts evaluates PiyoLog data with rules which flag the logs to
+// follow up, such as a fever and too few diapers.
+//
+// The rules are not medical advice. The default thresholds follow common
+// pediatric guidance and should be adjusted to the advice of the doctor.
+package alerts
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/kaneshin/piyolog"
+)
+
+// Severity represents how urgent an alert is.
+type Severity int
+
+const (
+	Info Severity = iota + 1
+	Warning
+	Critical
+)
+
+func (s Severity) String() string {
+	switch s {
+	case Info:
+		return "info"
+	case Warning:
+		return "warning"
+	case Critical:
+		return "critical"
+	}
+	return ""
+}
+
+// Alert represents a flag raised by a rule.
+type Alert struct {
+	// Rule is the name of the rule, such as "fever".
+	Rule     string
+	Severity Severity
+	// Date is the date of the entry of the last log.
+	Date time.Time
+	// Time is the time of the last log, which is zero for the alerts of a day.
+	Time    time.Time
+	Message string
+	// Logs are the logs which trigger the alert in chronological order.
+	Logs []piyolog.Log
+}
+
+func (a Alert) String() string {
+	at := a.Time.Format("2006-01-02 15:04")
+	if a.Time.IsZero() {
+		at = a.Date.Format(time.DateOnly)
+	}
+	return fmt.Sprintf("%s: %s: %s: %s", at, a.Severity, a.Rule, a.Message)
+}
+
+// at returns the time of the alert, which is the date for the alerts of a day.
+func (a Alert) at() time.Time {
+	if a.Time.IsZero() {
+		return a.Date
+	}
+	return a.Time
+}
+
+// Rule is the interface that evaluates data.
+type Rule interface {
+	Evaluate(data *piyolog.Data) []Alert
+}
+
+// RuleFunc is an adapter to use a function as a Rule.
+type RuleFunc func(data *piyolog.Data) []Alert
+
+// Evaluate calls f(data).
+func (f RuleFunc) Evaluate(data *piyolog.Data) []Alert {
+	return f(data)
+}
+
+// DefaultRules returns the rules of the default options.
+func DefaultRules() []Rule {
+	return []Rule{
+		Fever{},
+		SustainedFever{},
+		RisingTemperature{},
+		ImplausibleTemperature{},
+		DiaperOutput{},
+		NoStool{},
+	}
+}
+
+// Evaluate returns the alerts of the rules in chronological order.
+// The default rules are used if no rule is given.
+func Evaluate(data *piyolog.Data, rules ...Rule) []Alert {
+	if len(rules) == 0 {
+		rules = DefaultRules()
+	}
+	var alerts []Alert
+	for _, r := range rules {
+		alerts = append(alerts, r.Evaluate(data)...)
+	}
+	sort.SliceStable(alerts, func(i, j int) bool {
+		return alerts[i].at().Before(alerts[j].at())
+	})
+	return alerts
+}
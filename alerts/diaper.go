@@ -0,0 +1,156 @@
+package alerts
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kaneshin/piyolog"
+)
+
+// DiaperMinimum holds the least numbers of the wet and dirty diapers a day for
+// the babies younger than MaxAgeDays. MaxAgeDays of 0 means any age.
+type DiaperMinimum struct {
+	MaxAgeDays int
+	Wet, Dirty int
+}
+
+// DefaultDiaperMinimums are the default minimums of DiaperOutput: the wet
+// diapers increase by one a day up to 6 on the 6th day, and 3 dirty diapers
+// a day are expected from the 3rd day to 6 weeks.
+var DefaultDiaperMinimums = []DiaperMinimum{
+	{MaxAgeDays: 1, Wet: 1, Dirty: 1},
+	{MaxAgeDays: 2, Wet: 2, Dirty: 2},
+	{MaxAgeDays: 3, Wet: 3, Dirty: 3},
+	{MaxAgeDays: 4, Wet: 4, Dirty: 3},
+	{MaxAgeDays: 5, Wet: 5, Dirty: 3},
+	{MaxAgeDays: 42, Wet: 6, Dirty: 3},
+	{Wet: 6},
+}
+
+// diapers returns the numbers of the wet and dirty diapers of the entry, which
+// are the larger of the logs and the totals of the results.
+func diapers(e piyolog.Entry) (wet, dirty int) {
+	for _, l := range e.Logs {
+		switch l.(type) {
+		case piyolog.PeeLog:
+			wet++
+		case piyolog.PoopLog:
+			dirty++
+		}
+	}
+	return max(wet, e.Summary.PeeCount), max(dirty, e.Summary.PoopCount)
+}
+
+// DiaperOutput is the rule which flags the days with fewer wet or dirty
+// diapers than the minimums by the age of the baby. The entries without the
+// baby are skipped since the age is unknown, and every entry is regarded as a
+// whole day.
+type DiaperOutput struct {
+	// Minimums are in ascending order of MaxAgeDays.
+	// They default to DefaultDiaperMinimums.
+	Minimums []DiaperMinimum
+}
+
+func (r DiaperOutput) minimum(days int) DiaperMinimum {
+	minimums := r.Minimums
+	if len(minimums) == 0 {
+		minimums = DefaultDiaperMinimums
+	}
+	for _, m := range minimums {
+		if m.MaxAgeDays == 0 || days < m.MaxAgeDays {
+			return m
+		}
+	}
+	return minimums[len(minimums)-1]
+}
+
+func (r DiaperOutput) Evaluate(data *piyolog.Data) []Alert {
+	var alerts []Alert
+	for _, e := range data.Entries {
+		if e.Baby == nil || e.Baby.DateOfBirth.IsZero() {
+			continue
+		}
+		days := e.Baby.AgeDays(e.Date)
+		m := r.minimum(days)
+		wet, dirty := diapers(e)
+		var logs []piyolog.Log
+		for _, l := range e.Logs {
+			switch l.(type) {
+			case piyolog.PeeLog, piyolog.PoopLog:
+				logs = append(logs, l)
+			}
+		}
+		if wet < m.Wet {
+			alerts = append(alerts, Alert{
+				Rule:     "diaper-output",
+				Severity: Warning,
+				Date:     e.Date,
+				Message:  fmt.Sprintf("%d wet diapers, %d expected at %d days old", wet, m.Wet, days),
+				Logs:     logs,
+			})
+		}
+		if dirty < m.Dirty {
+			alerts = append(alerts, Alert{
+				Rule:     "diaper-output",
+				Severity: Warning,
+				Date:     e.Date,
+				Message:  fmt.Sprintf("%d dirty diapers, %d expected at %d days old", dirty, m.Dirty, days),
+				Logs:     logs,
+			})
+		}
+	}
+	return alerts
+}
+
+// NoStool is the rule which flags the consecutive days without stool. It is
+// a warning for the babies younger than 6 weeks, otherwise an information
+// since a stool every several days can be normal for them. A date without the
+// entry ends the days since it is unknown.
+type NoStool struct {
+	// Days defaults to 3.
+	Days int
+}
+
+func (r NoStool) Evaluate(data *piyolog.Data) []Alert {
+	n := r.Days
+	if n <= 0 {
+		n = 3
+	}
+	var (
+		alerts []Alert
+		since  time.Time
+		last   piyolog.Entry
+		count  int
+	)
+	flush := func() {
+		if count >= n {
+			severity := Info
+			if last.Baby != nil && !last.Baby.DateOfBirth.IsZero() && last.Baby.AgeDays(last.Date) < 42 {
+				severity = Warning
+			}
+			alerts = append(alerts, Alert{
+				Rule:     "no-stool",
+				Severity: severity,
+				Date:     last.Date,
+				Message:  fmt.Sprintf("no stool for %d days since %s", count, since.Format(time.DateOnly)),
+			})
+		}
+		count = 0
+	}
+	for _, e := range data.Entries {
+		if count > 0 && !e.Date.Equal(last.Date.AddDate(0, 0, 1)) {
+			flush()
+		}
+		if _, dirty := diapers(e); dirty > 0 {
+			flush()
+			continue
+		}
+		if count == 0 {
+			since = e.Date
+		}
+		count++
+		last = e
+	}
+	flush()
+	return alerts
+}
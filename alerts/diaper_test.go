@@ -0,0 +1,87 @@
+package alerts
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_Diapers(t *testing.T) {
+	data := parse(t, `【ぴよログ】2023年3月
+----------
+2023/3/6(月)
+ごふあ (0歳0か月2日)
+
+03:00   おしっこ   
+06:00   うんち   
+09:00   おしっこ   
+12:00   おしっこ   
+
+おしっこ合計   3回
+うんち合計   1回
+
+----------
+2023/3/7(火)
+ごふあ (0歳0か月3日)
+
+03:00   おしっこ   
+
+おしっこ合計   5回
+
+----------
+2023/3/8(水)
+ごふあ (0歳0か月4日)
+
+03:00   おしっこ   
+06:00   おしっこ   
+09:00   おしっこ   
+12:00   おしっこ   
+15:00   おしっこ   
+----------
+2023/3/9(木)
+ごふあ (0歳0か月5日)
+
+----------
+2023/3/11(土)
+ごふあ (0歳0か月7日)
+
+----------
+`)
+	var got []string
+	for _, a := range Evaluate(data, DiaperOutput{}, NoStool{}) {
+		got = append(got, a.String())
+	}
+	want := []string{
+		"2023-03-06: warning: diaper-output: 1 dirty diapers, 3 expected at 2 days old",
+		"2023-03-07: warning: diaper-output: 0 dirty diapers, 3 expected at 3 days old",
+		"2023-03-08: warning: diaper-output: 0 dirty diapers, 3 expected at 4 days old",
+		"2023-03-09: warning: diaper-output: 0 wet diapers, 6 expected at 5 days old",
+		"2023-03-09: warning: diaper-output: 0 dirty diapers, 3 expected at 5 days old",
+		"2023-03-09: warning: no-stool: no stool for 3 days since 2023-03-07",
+		"2023-03-11: warning: diaper-output: 0 wet diapers, 6 expected at 7 days old",
+		"2023-03-11: warning: diaper-output: 0 dirty diapers, 3 expected at 7 days old",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Evaluate failure: %s", diff)
+	}
+
+	data = parse(t, `【ぴよログ】2023年3月
+----------
+2023/3/7(火)
+ごふあ (0歳3か月0日)
+
+----------
+2023/3/8(水)
+ごふあ (0歳3か月1日)
+
+----------
+2023/3/9(木)
+ごふあ (0歳3か月2日)
+
+----------
+`)
+	alerts := NoStool{}.Evaluate(data)
+	if len(alerts) != 1 || alerts[0].Severity != Info {
+		t.Errorf("NoStool = %v", alerts)
+	}
+}
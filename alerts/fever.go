@@ -0,0 +1,251 @@
+package alerts
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/kaneshin/piyolog"
+)
+
+// minPlausible and maxPlausible are the default range of a body temperature
+// in °C.
+const (
+	minPlausible = 34
+	maxPlausible = 43
+)
+
+// reading is a body temperature log with the entry.
+type reading struct {
+	date    time.Time
+	baby    *piyolog.Baby
+	log     piyolog.BodyTemperatureLog
+	celsius float64
+}
+
+func (r reading) alert(rule string, severity Severity, message string, logs ...piyolog.Log) Alert {
+	return Alert{
+		Rule:     rule,
+		Severity: severity,
+		Date:     r.date,
+		Time:     r.log.CreatedAt(),
+		Message:  message,
+		Logs:     logs,
+	}
+}
+
+// readings returns the body temperature logs of the known units in
+// chronological order.
+func readings(data *piyolog.Data) []reading {
+	var rs []reading
+	for _, e := range data.Entries {
+		for _, l := range e.Logs {
+			v, ok := l.(piyolog.BodyTemperatureLog)
+			if !ok || v.Temperature.Unit == piyolog.TemperatureUnitUnknown {
+				continue
+			}
+			rs = append(rs, reading{
+				date:    e.Date,
+				baby:    e.Baby,
+				log:     v,
+				celsius: v.Temperature.In(piyolog.Celsius).Value,
+			})
+		}
+	}
+	sort.SliceStable(rs, func(i, j int) bool {
+		return rs[i].log.CreatedAt().Before(rs[j].log.CreatedAt())
+	})
+	return rs
+}
+
+// plausible returns the readings in the range of a body temperature, so that
+// the rules of a fever ignore the ones flagged by ImplausibleTemperature.
+func plausible(rs []reading) []reading {
+	var ps []reading
+	for _, rd := range rs {
+		if rd.celsius >= minPlausible && rd.celsius <= maxPlausible {
+			ps = append(ps, rd)
+		}
+	}
+	return ps
+}
+
+func celsius(v float64) piyolog.Temperature {
+	return piyolog.Temperature{Value: v, Unit: piyolog.Celsius}
+}
+
+// FeverThreshold holds the temperatures of a fever for the babies younger than
+// MaxAgeDays. MaxAgeDays of 0 means any age.
+type FeverThreshold struct {
+	MaxAgeDays        int
+	Warning, Critical piyolog.Temperature
+}
+
+// DefaultFeverThresholds are the default thresholds of Fever: any fever under
+// 3 months, 38.9°C under 6 months and 40°C after that are critical.
+var DefaultFeverThresholds = []FeverThreshold{
+	{MaxAgeDays: 90, Warning: celsius(38), Critical: celsius(38)},
+	{MaxAgeDays: 183, Warning: celsius(38), Critical: celsius(38.9)},
+	{Warning: celsius(38), Critical: celsius(40)},
+}
+
+// Fever is the rule which flags the temperatures of a fever by the age of the
+// baby. The thresholds of the youngest age are used if the age is unknown.
+type Fever struct {
+	// Thresholds are in ascending order of MaxAgeDays.
+	// They default to DefaultFeverThresholds.
+	Thresholds []FeverThreshold
+}
+
+func (r Fever) threshold(rd reading) (FeverThreshold, string) {
+	thresholds := r.Thresholds
+	if len(thresholds) == 0 {
+		thresholds = DefaultFeverThresholds
+	}
+	if rd.baby == nil || rd.baby.DateOfBirth.IsZero() {
+		return thresholds[0], "unknown age"
+	}
+	days := rd.baby.AgeDays(rd.log.CreatedAt())
+	for _, t := range thresholds {
+		if t.MaxAgeDays == 0 || days < t.MaxAgeDays {
+			return t, fmt.Sprintf("%d days old", days)
+		}
+	}
+	return thresholds[len(thresholds)-1], fmt.Sprintf("%d days old", days)
+}
+
+func (r Fever) Evaluate(data *piyolog.Data) []Alert {
+	var alerts []Alert
+	for _, rd := range plausible(readings(data)) {
+		t, age := r.threshold(rd)
+		var severity Severity
+		switch {
+		case rd.celsius >= t.Critical.In(piyolog.Celsius).Value:
+			severity = Critical
+		case rd.celsius >= t.Warning.In(piyolog.Celsius).Value:
+			severity = Warning
+		default:
+			continue
+		}
+		message := fmt.Sprintf("%s at %s", rd.log.Temperature, age)
+		alerts = append(alerts, rd.alert("fever", severity, message, rd.log))
+	}
+	return alerts
+}
+
+// SustainedFever is the rule which flags a fever lasting long, which is the
+// readings at or above the threshold without a reading below it.
+type SustainedFever struct {
+	// Threshold defaults to 38°C.
+	Threshold piyolog.Temperature
+	// Duration defaults to 24 hours.
+	Duration time.Duration
+}
+
+func (r SustainedFever) Evaluate(data *piyolog.Data) []Alert {
+	threshold := r.Threshold
+	if threshold.Unit == piyolog.TemperatureUnitUnknown {
+		threshold = celsius(38)
+	}
+	duration := r.Duration
+	if duration <= 0 {
+		duration = 24 * time.Hour
+	}
+	var (
+		alerts  []Alert
+		episode []reading
+	)
+	flush := func() {
+		if len(episode) == 0 {
+			return
+		}
+		first, last := episode[0], episode[len(episode)-1]
+		if d := last.log.CreatedAt().Sub(first.log.CreatedAt()); d >= duration {
+			var logs []piyolog.Log
+			for _, rd := range episode {
+				logs = append(logs, rd.log)
+			}
+			message := fmt.Sprintf("fever for %s since %s", d, first.log.CreatedAt().Format("2006-01-02 15:04"))
+			alerts = append(alerts, last.alert("sustained-fever", Warning, message, logs...))
+		}
+		episode = nil
+	}
+	for _, rd := range plausible(readings(data)) {
+		if rd.celsius < threshold.In(piyolog.Celsius).Value {
+			flush()
+			continue
+		}
+		episode = append(episode, rd)
+	}
+	flush()
+	return alerts
+}
+
+// RisingTemperature is the rule which flags a temperature rising quickly.
+type RisingTemperature struct {
+	// Rise is the rise in °C. It defaults to 1.
+	Rise float64
+	// Within defaults to 6 hours.
+	Within time.Duration
+}
+
+func (r RisingTemperature) Evaluate(data *piyolog.Data) []Alert {
+	rise := r.Rise
+	if rise <= 0 {
+		rise = 1
+	}
+	within := r.Within
+	if within <= 0 {
+		within = 6 * time.Hour
+	}
+	rs := plausible(readings(data))
+	var alerts []Alert
+	for i := 1; i < len(rs); i++ {
+		cur := rs[i]
+		if cur.celsius <= rs[i-1].celsius {
+			continue
+		}
+		low := -1
+		for j := i - 1; j >= 0 && cur.log.CreatedAt().Sub(rs[j].log.CreatedAt()) <= within; j-- {
+			if low < 0 || rs[j].celsius < rs[low].celsius {
+				low = j
+			}
+		}
+		if low < 0 || cur.celsius-rs[low].celsius < rise {
+			continue
+		}
+		message := fmt.Sprintf("rising from %s to %s in %s",
+			rs[low].log.Temperature, cur.log.Temperature,
+			cur.log.CreatedAt().Sub(rs[low].log.CreatedAt()))
+		alerts = append(alerts, cur.alert("rising-temperature", Info, message, rs[low].log, cur.log))
+	}
+	return alerts
+}
+
+// ImplausibleTemperature is the rule which flags a temperature out of the
+// range of a body temperature, which is likely written in the other unit such
+// as "98.6°C".
+type ImplausibleTemperature struct {
+	// Min and Max default to 34°C and 43°C. The rules of a fever ignore the
+	// temperatures out of the default range.
+	Min, Max piyolog.Temperature
+}
+
+func (r ImplausibleTemperature) Evaluate(data *piyolog.Data) []Alert {
+	min, max := r.Min, r.Max
+	if min.Unit == piyolog.TemperatureUnitUnknown {
+		min = celsius(minPlausible)
+	}
+	if max.Unit == piyolog.TemperatureUnitUnknown {
+		max = celsius(maxPlausible)
+	}
+	var alerts []Alert
+	for _, rd := range readings(data) {
+		if rd.celsius >= min.In(piyolog.Celsius).Value && rd.celsius <= max.In(piyolog.Celsius).Value {
+			continue
+		}
+		message := fmt.Sprintf("%s is out of the range of %s to %s", rd.log.Temperature, min, max)
+		alerts = append(alerts, rd.alert("implausible-temperature", Warning, message, rd.log))
+	}
+	return alerts
+}
@@ -0,0 +1,79 @@
+package alerts
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kaneshin/piyolog"
+)
+
+func parse(t *testing.T, s string) *piyolog.Data {
+	t.Helper()
+	data, err := piyolog.Parse(s)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	return data
+}
+
+func Test_Evaluate(t *testing.T) {
+	data := parse(t, `【ぴよログ】2023年3月
+----------
+2023/3/7(火)
+ごふあ (0歳0か月20日)
+
+08:00   体温 36.8°C   
+12:00   体温 37.9°C   
+13:00   体温 38.2°C   
+
+----------
+2023/3/8(水)
+ごふあ (0歳0か月21日)
+
+14:00   体温 101.0°F   
+15:00   体温 98.6°C   
+20:00   体温 37.0°C   
+----------
+`)
+	alerts := Evaluate(data, Fever{}, SustainedFever{}, RisingTemperature{}, ImplausibleTemperature{})
+	var got []string
+	for _, a := range alerts {
+		got = append(got, a.String())
+	}
+	want := []string{
+		"2023-03-07 12:00: info: rising-temperature: rising from 36.8°C to 37.9°C in 4h0m0s",
+		"2023-03-07 13:00: critical: fever: 38.2°C at 20 days old",
+		"2023-03-07 13:00: info: rising-temperature: rising from 36.8°C to 38.2°C in 5h0m0s",
+		"2023-03-08 14:00: critical: fever: 101°F at 21 days old",
+		"2023-03-08 14:00: warning: sustained-fever: fever for 25h0m0s since 2023-03-07 13:00",
+		"2023-03-08 15:00: warning: implausible-temperature: 98.6°C is out of the range of 34°C to 43°C",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Evaluate failure: %s", diff)
+	}
+	if n := len(alerts[4].Logs); n != 2 {
+		t.Errorf("sustained-fever must have 2 logs: %d", n)
+	}
+}
+
+func Test_Fever(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Severity
+	}{
+		{in: "【ぴよログ】2023/3/8(水)\nごふあ (0歳8か月0日)\n\n03:00   体温 38.5°C   \n", want: Warning},
+		{in: "【ぴよログ】2023/3/8(水)\nごふあ (0歳8か月0日)\n\n03:00   体温 104.2°F   \n", want: Critical},
+		{in: "【ぴよログ】2023/3/8(水)\nごふあ (0歳4か月0日)\n\n03:00   体温 39.0°C   \n", want: Critical},
+		{in: "【ぴよログ】2023/3/8(水)\n\n03:00   体温 38.0°C   \n", want: Critical},
+		{in: "【ぴよログ】2023/3/8(水)\n\n03:00   体温 37.9°C   \n"},
+	}
+	for _, tt := range tests {
+		var got Severity
+		if alerts := (Fever{}).Evaluate(parse(t, tt.in)); len(alerts) > 0 {
+			got = alerts[0].Severity
+		}
+		if got != tt.want {
+			t.Errorf("Fever(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,383 @@
+// Command piyolog parses PiyoLog export data and prints it in several formats.
+//
+// Usage:
+//
+//	piyolog <command> [flags] [file ...]
+//
+// The commands are:
+//
+//	parse     print the parsed data
+//	summary   print the daily totals
+//	export    print the logs
+//	validate  report lines which cannot be parsed and inconsistent daily totals
+//	merge     merge the data of the files into one
+//	diff      print the changes from the first file to the second one
+//
+// It reads the standard input if no file is given.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/kaneshin/piyolog"
+	"github.com/kaneshin/piyolog/export/csv"
+	"golang.org/x/text/language"
+)
+
+const usage = `usage: piyolog <command> [flags] [file ...]
+
+commands:
+  parse     print the parsed data (default format: json)
+  summary   print the daily totals (default format: text)
+  export    print the logs (default format: csv)
+  validate  report lines which cannot be parsed and inconsistent daily totals
+  merge     merge the data of the files into one (default format: text)
+  diff      print the changes from the first file to the second one (default format: text)
+
+run "piyolog <command> -h" for the flags.
+`
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+type command struct {
+	name   string
+	format string
+	run    func(c *cli, names []string) error
+}
+
+var commands = []command{
+	{"parse", "json", withData((*cli).parse, false)},
+	{"summary", "text", withData((*cli).summary, false)},
+	{"export", "csv", withData((*cli).export, false)},
+	{"validate", "text", withData((*cli).validate, false)},
+	{"merge", "text", withData((*cli).merge, true)},
+	{"diff", "text", (*cli).diff},
+}
+
+// withData returns the function which runs f with the data of the files.
+// See (*cli).read for merge.
+func withData(f func(c *cli, data *piyolog.Data) error, merge bool) func(c *cli, names []string) error {
+	return func(c *cli, names []string) error {
+		data, err := c.read(names, merge)
+		if err != nil {
+			return err
+		}
+		return f(c, data)
+	}
+}
+
+// errInvalid is returned when the data is not valid.
+var errInvalid = errors.New("invalid data")
+
+type cli struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+
+	lang     string
+	tag      language.Tag
+	loc      *time.Location
+	from, to time.Time
+	format   string
+	strict   bool
+
+	warnings int
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+	var cmd *command
+	for i := range commands {
+		if commands[i].name == args[0] {
+			cmd = &commands[i]
+		}
+	}
+	if cmd == nil {
+		fmt.Fprintf(stderr, "piyolog: unknown command %q\n\n%s", args[0], usage)
+		return 2
+	}
+
+	c := &cli{
+		stdin:  stdin,
+		stdout: stdout,
+		stderr: stderr,
+	}
+	fs := flag.NewFlagSet("piyolog "+cmd.name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&c.lang, "lang", "", "language of the headers and the types of -format csv, such as ja and en (default: the one of the input)")
+	tz := fs.String("tz", "Asia/Tokyo", "time zone of the data")
+	from := fs.String("from", "", "print the entries on and after the date (YYYY-MM-DD)")
+	to := fs.String("to", "", "print the entries on and before the date (YYYY-MM-DD)")
+	fs.StringVar(&c.format, "format", cmd.format, "output format: json, csv or text")
+	fs.BoolVar(&c.strict, "strict", false, "fail on the first line which cannot be parsed")
+	if err := fs.Parse(args[1:]); err != nil {
+		return 2
+	}
+
+	var err error
+	if c.loc, err = time.LoadLocation(*tz); err != nil {
+		fmt.Fprintf(stderr, "piyolog: %v\n", err)
+		return 2
+	}
+	if c.from, err = c.parseDate(*from); err != nil {
+		fmt.Fprintf(stderr, "piyolog: -from: %v\n", err)
+		return 2
+	}
+	if c.to, err = c.parseDate(*to); err != nil {
+		fmt.Fprintf(stderr, "piyolog: -to: %v\n", err)
+		return 2
+	}
+	switch c.format {
+	case "json", "csv", "text":
+	default:
+		fmt.Fprintf(stderr, "piyolog: unknown format %q\n", c.format)
+		return 2
+	}
+	if c.lang != "" {
+		tag, err := language.Parse(c.lang)
+		if _, ok := piyolog.LookupLocale(tag); err != nil || !ok {
+			fmt.Fprintf(stderr, "piyolog: unknown language %q\n", c.lang)
+			return 2
+		}
+		// the other formats write the data as it is, which cannot be
+		// translated.
+		if c.format != "csv" {
+			fmt.Fprintln(stderr, "piyolog: -lang can be used only with -format csv")
+			return 2
+		}
+		c.tag = tag
+	}
+
+	if err := cmd.run(c, fs.Args()); err != nil {
+		if !errors.Is(err, errInvalid) {
+			fmt.Fprintf(stderr, "piyolog: %v\n", err)
+		}
+		return 1
+	}
+	return 0
+}
+
+func (c *cli) parseDate(str string) (time.Time, error) {
+	if str == "" {
+		return time.Time{}, nil
+	}
+	return time.ParseInLocation(time.DateOnly, str, c.loc)
+}
+
+// read returns the data of the files. The data of every file is merged into one
+// if merge is true, otherwise the files are read as one data.
+func (c *cli) read(names []string, merge bool) (*piyolog.Data, error) {
+	if len(names) == 0 {
+		names = []string{"-"}
+	}
+	var all []*piyolog.Data
+	for _, name := range names {
+		data, err := c.readFile(name)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, data)
+	}
+	data := all[0]
+	if len(all) > 1 {
+		if !merge {
+			return nil, fmt.Errorf("too many files: use merge command to read %d files", len(all))
+		}
+		for _, d := range all[1:] {
+			merged, report, err := piyolog.MergeWithReport(data, d)
+			if err != nil {
+				return nil, err
+			}
+			for _, conflict := range report.Conflicts {
+				fmt.Fprintf(c.stderr, "piyolog: %s\n", conflict)
+			}
+			c.warnings += len(report.Conflicts)
+			data = merged
+		}
+	}
+	c.filter(data)
+	return data, nil
+}
+
+func (c *cli) readFile(name string) (*piyolog.Data, error) {
+	r := c.stdin
+	if name == "-" {
+		name = "<stdin>"
+	} else {
+		f, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+	data, warnings, err := piyolog.ParseOptions{
+		Strict:   c.strict,
+		Location: c.loc,
+	}.ParseReader(r)
+	for _, w := range warnings {
+		c.printParseError(name, piyolog.ParseError(w))
+	}
+	c.warnings += len(warnings)
+	var perr *piyolog.ParseError
+	if errors.As(err, &perr) {
+		c.printParseError(name, *perr)
+		return nil, errInvalid
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return data, nil
+}
+
+func (c *cli) printParseError(name string, e piyolog.ParseError) {
+	fmt.Fprintf(c.stderr, "%s:%d: %s in %s section: %q\n", name, e.Line, e.Err, e.Section, e.Text)
+}
+
+// filter removes the entries out of the range of the dates.
+func (c *cli) filter(data *piyolog.Data) {
+	entries := data.Entries[:0]
+	for _, e := range data.Entries {
+		if !c.from.IsZero() && e.Date.Before(c.from) {
+			continue
+		}
+		if !c.to.IsZero() && e.Date.After(c.to) {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	data.Entries = entries
+}
+
+func (c *cli) csvWriter() *csv.Writer {
+	w := csv.NewWriter(c.stdout)
+	w.Tag = c.tag
+	return w
+}
+
+func (c *cli) writeJSON(v any) error {
+	enc := json.NewEncoder(c.stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func (c *cli) parse(data *piyolog.Data) error {
+	switch c.format {
+	case "csv":
+		return c.csvWriter().WriteLogs(data)
+	case "text":
+		_, err := data.WriteTo(c.stdout)
+		return err
+	}
+	return c.writeJSON(data)
+}
+
+func (c *cli) summary(data *piyolog.Data) error {
+	switch c.format {
+	case "csv":
+		return c.csvWriter().WriteSummaries(data)
+	case "json":
+		type summary struct {
+			Date    time.Time            `json:"date"`
+			Summary piyolog.DailySummary `json:"summary"`
+		}
+		summaries := []summary{}
+		for _, e := range data.Entries {
+			summaries = append(summaries, summary{e.Date, e.Summary})
+		}
+		return c.writeJSON(summaries)
+	}
+	for _, e := range data.Entries {
+		s := e.Summary
+		fmt.Fprintf(c.stdout, "%s\n", e.Date.Format(time.DateOnly))
+		fmt.Fprintf(c.stdout, "  nursing: left %s / right %s\n", s.NursingLeft, s.NursingRight)
+		fmt.Fprintf(c.stdout, "  formula: %d times %s\n", s.FormulaCount, s.FormulaVolume)
+		fmt.Fprintf(c.stdout, "  sleep:   %s\n", s.SleepTotal)
+		fmt.Fprintf(c.stdout, "  pee:     %d times\n", s.PeeCount)
+		fmt.Fprintf(c.stdout, "  poop:    %d times\n", s.PoopCount)
+	}
+	return nil
+}
+
+func (c *cli) export(data *piyolog.Data) error {
+	switch c.format {
+	case "json":
+		return c.writeJSON(data)
+	case "text":
+		_, err := data.WriteTo(c.stdout)
+		return err
+	}
+	return c.csvWriter().WriteLogs(data)
+}
+
+func (c *cli) validate(data *piyolog.Data) error {
+	ds := data.Verify()
+	if c.format == "json" {
+		if ds == nil {
+			ds = []piyolog.Discrepancy{}
+		}
+		if err := c.writeJSON(ds); err != nil {
+			return err
+		}
+	} else {
+		for _, d := range ds {
+			fmt.Fprintln(c.stdout, d)
+		}
+	}
+	if len(ds) > 0 || c.warnings > 0 {
+		return errInvalid
+	}
+	return nil
+}
+
+func (c *cli) merge(data *piyolog.Data) error {
+	switch c.format {
+	case "json":
+		return c.writeJSON(data)
+	case "csv":
+		return c.csvWriter().WriteLogs(data)
+	}
+	_, err := data.WriteTo(c.stdout)
+	return err
+}
+
+func (c *cli) diff(names []string) error {
+	if len(names) != 2 {
+		return fmt.Errorf("diff needs 2 files, got %d", len(names))
+	}
+	old, err := c.readFile(names[0])
+	if err != nil {
+		return err
+	}
+	new, err := c.readFile(names[1])
+	if err != nil {
+		return err
+	}
+	c.filter(old)
+	c.filter(new)
+	changes, err := piyolog.Diff(old, new)
+	if err != nil {
+		return err
+	}
+	if c.format == "json" {
+		if changes == nil {
+			changes = []piyolog.Change{}
+		}
+		return c.writeJSON(changes)
+	}
+	for _, change := range changes {
+		fmt.Fprintln(c.stdout, change)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_run(t *testing.T) {
+	broken := `【ぴよログ】2023/3/8(水)
+
+03:00   ミルク 90ml   
+03:10   ミルク   
+ミルクをあげた
+
+ミルク合計　   2回 180ml
+`
+	tests := []struct {
+		args   []string
+		stdin  string
+		code   int
+		stdout string
+		stderr string
+	}{
+		{
+			args: []string{"summary", "testdata/daily.txt"},
+			stdout: `2023-03-08
+  nursing: left 20m0s / right 20m0s
+  formula: 7 times 600ml
+  sleep:   15h30m0s
+  pee:     7 times
+  poop:    1 times
+`,
+		},
+		{
+			args:   []string{"summary", "-format", "csv", "-lang", "en", "-to", "2023-03-07", "testdata/daily.txt"},
+			stdout: "Date,Baby,Nursing left (min),Nursing right (min),Formula count,Formula amount,Formula unit,Pumped milk count,Pumped milk amount,Pumped milk unit,Solid count,Sleep (min),Pee count,Poop count\n",
+		},
+		{
+			args:   []string{"validate", "testdata/daily.txt"},
+			stdout: "",
+		},
+		{
+			args:  []string{"validate"},
+			stdin: broken,
+			code:  1,
+			stdout: `2023-03-08: formula count: reported 2, computed 1
+2023-03-08: formula amount: reported 180, computed 90
+`,
+			stderr: `<stdin>:4: invalid content: no amount in "" in logs section: "03:10   ミルク   "
+<stdin>:5: unknown line in logs section: "ミルクをあげた"
+`,
+		},
+		{
+			args:   []string{"export", "-strict"},
+			stdin:  broken,
+			code:   1,
+			stderr: `<stdin>:4: invalid content: no amount in "" in logs section: "03:10   ミルク   "` + "\n",
+		},
+		{
+			args:   []string{"export", "-format", "text"},
+			stdin:  "【ぴよログ】2023/3/8(水)\n\n03:00   ミルク 90ml   \n",
+			stdout: "【ぴよログ】2023/3/8(水)\n\n03:00   ミルク 90ml   \n",
+		},
+		{
+			args:   []string{"diff", "testdata/daily.txt", "testdata/daily.txt"},
+			stdout: "",
+		},
+		{
+			args:   []string{"diff", "testdata/daily.txt"},
+			code:   1,
+			stderr: "piyolog: diff needs 2 files, got 1\n",
+		},
+		{
+			args:   []string{"export", "-lang", "en", "-to", "2023-03-08"},
+			stdin:  "【ぴよログ】2023/3/8(水)\n\n03:00   ミルク 90ml   \n",
+			stdout: "Date,Time,Baby,Type,Amount,Unit,Duration (min),Temperature,Left (min),Right (min),Notes\n2023-03-08,03:00,,Formula,90,ml,,,,,\n",
+		},
+		{
+			args:   []string{"parse", "-lang", "en", "testdata/daily.txt"},
+			code:   2,
+			stderr: "piyolog: -lang can be used only with -format csv\n",
+		},
+		{
+			args:   []string{"summary", "-lang", "en", "testdata/daily.txt"},
+			code:   2,
+			stderr: "piyolog: -lang can be used only with -format csv\n",
+		},
+		{
+			args:   []string{"merge", "-format", "text", "-lang", "en", "testdata/daily.txt"},
+			code:   2,
+			stderr: "piyolog: -lang can be used only with -format csv\n",
+		},
+		{
+			args: []string{"unknown"},
+			code: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+			if code != tt.code {
+				t.Errorf("wrong exit code: want %d, got %d: %s", tt.code, code, stderr.String())
+			}
+			if diff := cmp.Diff(tt.stdout, stdout.String()); diff != "" {
+				t.Errorf("%s", diff)
+			}
+			if tt.stderr != "" {
+				if diff := cmp.Diff(tt.stderr, stderr.String()); diff != "" {
+					t.Errorf("%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func Test_merge(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"merge", "-format", "csv", "testdata/daily.txt", "testdata/daily.txt"}, nil, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("wrong exit code: %d: %s", code, stderr.String())
+	}
+	if n := strings.Count(stdout.String(), "\n"); n != 33 {
+		t.Errorf("wrong number of lines: want 33, got %d", n)
+	}
+}
@@ -0,0 +1,278 @@
+package piyolog
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// maxLineSize is the maximum size of a line the Decoder can scan.
+const maxLineSize = 1024 * 1024
+
+// scanLines is a bufio.SplitFunc like bufio.ScanLines, which also splits at
+// escaped line breaks so that the export data of a single line is scanned
+// line by line too.
+func scanLines(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+	for i := 0; i < len(data); i++ {
+		switch data[i] {
+		case '\n':
+			return i + 1, bytes.TrimSuffix(data[:i], []byte{'\r'}), nil
+		case '\\':
+			if i+1 < len(data) && data[i+1] == 'n' {
+				return i + 2, data[:i], nil
+			}
+		}
+	}
+	if !atEOF {
+		// request more data.
+		return 0, nil, nil
+	}
+	return len(data), bytes.TrimSuffix(data, []byte{'\r'}), nil
+}
+
+// A Decoder reads and decodes PiyoLog export data entry by entry from an input stream.
+type Decoder struct {
+	opts     ParseOptions
+	scanner  *bufio.Scanner
+	data     *Data
+	entry    *Entry
+	lineno   int
+	blanks   int
+	refine   bool
+	skip     bool
+	warnings []ParseWarning
+	err      error
+}
+
+// ParseOptions represents options to parse export data.
+type ParseOptions struct {
+	// Strict makes parsing fail with a *ParseError on the first line that cannot be parsed.
+	// Otherwise, such lines are skipped and reported as warnings.
+	Strict bool
+	// Location is the location of the dates and times of the data.
+	// If it is nil, the location set by SetLocation is used.
+	Location *time.Location
+}
+
+// NewDecoder returns a new decoder that reads from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return ParseOptions{}.NewDecoder(r)
+}
+
+// NewDecoder returns a new decoder that reads from r with the options.
+func (opts ParseOptions) NewDecoder(r io.Reader) *Decoder {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLineSize)
+	scanner.Split(scanLines)
+	if opts.Location == nil {
+		opts.Location = piyoLoc
+	}
+	return &Decoder{
+		opts:    opts,
+		scanner: scanner,
+	}
+}
+
+// Warnings returns the lines skipped so far in lenient mode.
+func (dec *Decoder) Warnings() []ParseWarning {
+	return dec.warnings
+}
+
+// Tag returns the language of the export data detected from its head.
+// The head of monthly data may not tell the locales of the same header apart,
+// then the language is refined by the weekday of the first date.
+func (dec *Decoder) Tag() language.Tag {
+	dec.head()
+	if dec.data == nil {
+		return language.Und
+	}
+	return dec.data.Tag
+}
+
+// Next returns the next entry in the input stream.
+// At the end of the input stream, Next returns nil, io.EOF.
+func (dec *Decoder) Next() (*Entry, error) {
+	if dec.head(); dec.err != nil {
+		return nil, dec.err
+	}
+	for {
+		line, ok := dec.line()
+		if !ok {
+			if dec.err = dec.scanner.Err(); dec.err != nil {
+				return nil, dec.err
+			}
+			// handle the end of the stream as if a separator of monthly data.
+			if e := dec.flush(); e != nil {
+				return e, nil
+			}
+			dec.err = io.EOF
+			return nil, dec.err
+		}
+		if strings.HasPrefix(line, piyologSeparator) {
+			dec.skip = false
+			if e := dec.flush(); e != nil {
+				return e, nil
+			}
+			continue
+		}
+		if line == "" {
+			// hold blank lines until the next line is read in order not to
+			// parse the new line before the separator.
+			dec.blanks++
+			continue
+		}
+		for ; dec.blanks > 0; dec.blanks-- {
+			dec.feed("")
+		}
+		if err := dec.feed(line); err != nil {
+			if dec.err = dec.fail(line, err); dec.err != nil {
+				return nil, dec.err
+			}
+		}
+	}
+}
+
+// head parses the head of the stream to detect its language.
+func (dec *Decoder) head() {
+	if dec.data != nil || dec.err != nil {
+		return
+	}
+	line, _ := dec.line()
+	if dec.err = dec.scanner.Err(); dec.err != nil {
+		return
+	}
+	head := strings.TrimSpace(line)
+	data := newData(head)
+	if data.Tag == language.Und && head != "" {
+		if dec.err = dec.fail(line, ErrUnknownFormat); dec.err != nil {
+			return
+		}
+	}
+	l := lookupLocale(data.Tag)
+	if l != nil {
+		_, head, _ = strings.Cut(head, l.Header)
+	}
+	// generate an entry with the head text.
+	dec.entry = data.newEntry(head, dec.opts.Location)
+	// the head of monthly data has no date, such as "【ぴよログ】2024年8月".
+	data.Monthly = dec.entry == nil && data.Tag != language.Und
+	dec.refine = data.Monthly && l.shared()
+	dec.data = &data
+}
+
+// line returns the next line of the stream.
+func (dec *Decoder) line() (string, bool) {
+	if !dec.scanner.Scan() {
+		return "", false
+	}
+	dec.lineno++
+	return dec.scanner.Text(), true
+}
+
+func (dec *Decoder) feed(line string) error {
+	if dec.entry != nil {
+		return dec.entry.apply(line)
+	}
+	if dec.skip {
+		// the lines of the entry whose date is invalid are skipped quietly
+		// until the next separator, since the date is reported once.
+		return nil
+	}
+	data := *dec.data
+	if dec.refine {
+		if l := detectLocale(lookupLocale(data.Tag).Header + line); l != nil {
+			data.Tag = l.Tag
+		}
+	}
+	if dec.entry = data.newEntry(line, dec.opts.Location); dec.entry == nil && line != "" {
+		dec.skip = true
+		return ErrInvalidDate
+	}
+	if dec.entry != nil && dec.refine {
+		dec.data.Tag = data.Tag
+		dec.refine = false
+	}
+	return nil
+}
+
+// fail returns a *ParseError of the current line in strict mode.
+// Otherwise, it records the error as a warning and returns nil.
+func (dec *Decoder) fail(line string, err error) error {
+	perr := &ParseError{
+		Line:    dec.lineno,
+		Text:    line,
+		Section: sectionDate.String(),
+		Err:     err,
+	}
+	if dec.entry != nil {
+		perr.Section = dec.entry.section.String()
+	}
+	if dec.opts.Strict {
+		return perr
+	}
+	dec.warnings = append(dec.warnings, ParseWarning(*perr))
+	return nil
+}
+
+// flush returns the current entry, dropping one blank line before the separator.
+func (dec *Decoder) flush() *Entry {
+	if dec.blanks > 0 {
+		dec.blanks--
+	}
+	for ; dec.blanks > 0; dec.blanks-- {
+		dec.feed("")
+	}
+	entry := dec.entry
+	dec.entry = nil
+	if entry != nil {
+		entry.section.end()
+	}
+	return entry
+}
+
+// ParseReader returns the Data value read from r.
+// Unlike Parse, it scans r line by line without buffering the whole export data.
+func ParseReader(r io.Reader) (*Data, error) {
+	data, _, err := ParseOptions{}.ParseReader(r)
+	return data, err
+}
+
+// Parse returns the Data value represented by the string with the options,
+// and the lines skipped in lenient mode.
+func (opts ParseOptions) Parse(str string) (*Data, []ParseWarning, error) {
+	return opts.ParseReader(strings.NewReader(str))
+}
+
+// ParseReader returns the Data value read from r with the options,
+// and the lines skipped in lenient mode.
+func (opts ParseOptions) ParseReader(r io.Reader) (*Data, []ParseWarning, error) {
+	dec := opts.NewDecoder(r)
+	data := Data{
+		Tag: dec.Tag(),
+	}
+	if dec.data != nil {
+		data.Monthly = dec.data.Monthly
+	}
+	for {
+		entry, err := dec.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, dec.Warnings(), err
+		}
+		data.Hour12 = data.Hour12 || entry.hour12
+		data.Entries = append(data.Entries, *entry)
+	}
+	if dec.data != nil {
+		data.Tag = dec.data.Tag
+	}
+	return &data, dec.Warnings(), nil
+}
@@ -0,0 +1,238 @@
+package piyolog
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"golang.org/x/text/language"
+)
+
+func Test_Decoder(t *testing.T) {
+	in := `【ぴよログ】2024年8月
+----------
+2024/8/1(木)
+ごふあ (0歳2か月10日)
+
+04:20 AM   ミルク 110ml   
+
+ミルク合計　   1回 110ml
+
+----------
+2024/8/2(金)
+ごふあ (0歳2か月11日)
+
+04:20 AM   ミルク 110ml   
+
+ミルク合計　   1回 110ml
+
+お食い初めだよ
+
+----------
+`
+	dec := NewDecoder(strings.NewReader(in))
+	if dec.Tag() != language.Japanese {
+		t.Errorf("wrong tag: %s", dec.Tag())
+	}
+	dates := []time.Time{
+		time.Date(2024, time.August, 1, 0, 0, 0, 0, piyoLoc),
+		time.Date(2024, time.August, 2, 0, 0, 0, 0, piyoLoc),
+	}
+	for _, date := range dates {
+		entry, err := dec.Next()
+		if err != nil {
+			t.Fatalf("unexpected error returned: %v", err)
+		}
+		if !entry.Date.Equal(date) {
+			t.Errorf("wrong date: want %v, got %v", date, entry.Date)
+		}
+		if len(entry.Logs) != 1 || len(entry.Results) != 1 {
+			t.Errorf("wrong entry: %v", entry)
+		}
+	}
+	if _, err := dec.Next(); err != io.EOF {
+		t.Errorf("io.EOF must be returned: %v", err)
+	}
+}
+
+func Test_ParseReader(t *testing.T) {
+	in := `[PiyoLog]Sun, Dec 31, 2023
+Gofua (0y1m1d)
+
+08:45 AM   Formula 140ml   
+01:55 PM   Sleep   
+
+Formula total   1 time(s) 140ml
+
+journal
+
+`
+	data, err := ParseReader(strings.NewReader(in))
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	if data.Tag != language.English {
+		t.Errorf("wrong tag: %s", data.Tag)
+	}
+	if len(data.Entries) != 1 {
+		t.Fatalf("wrong length: want 1, got %v", len(data.Entries))
+	}
+	entry := data.Entries[0]
+	want := Entry{
+		Date: time.Date(2023, time.December, 31, 0, 0, 0, 0, piyoLoc),
+		Baby: &Baby{
+			Name:        "Gofua",
+			DateOfBirth: time.Date(2023, time.November, 30, 0, 0, 0, 0, piyoLoc),
+		},
+		Logs: []Log{
+			FormulaLog{
+				LogItem: LogItem{
+					typ:       "Formula",
+					content:   "140ml",
+					createdAt: time.Date(2023, time.December, 31, 8, 45, 0, 0, piyoLoc),
+				},
+				Volume: Volume{Value: 140, Unit: Milliliter},
+			},
+			SleepLog{
+				LogItem: LogItem{
+					typ:       "Sleep",
+					createdAt: time.Date(2023, time.December, 31, 13, 55, 0, 0, piyoLoc),
+				},
+			},
+		},
+		Results: []string{
+			"Formula total   1 time(s) 140ml",
+		},
+		Summary: DailySummary{
+			FormulaCount:  1,
+			FormulaVolume: Volume{Value: 140, Unit: Milliliter},
+		},
+		Journal: "journal",
+	}
+	if diff := cmp.Diff(want, entry, cmpopts.EquateComparable(LogItem{}), cmpopts.IgnoreUnexported(Entry{})); diff != "" {
+		t.Errorf("%s", diff)
+	}
+}
+
+func Test_ParseOptions(t *testing.T) {
+	in := `【ぴよログ】2024年8月
+----------
+2024/8/1(木)
+ごふあ (0歳2か月10日)
+
+04:15 AM   起きる
+04:20 AM   ミルク 110ml   
+ミルクをあげた
+
+ミルク合計　   1回 110ml
+
+----------
+2024/8/32(土)
+
+04:20 AM   ミルク 110ml   
+ミルクをあげた
+
+----------
+2024/8/3(土)
+
+05:00 AM   ミルク 100ml   
+
+----------`
+	warnings := []ParseWarning{
+		{Line: 6, Text: "04:15 AM   起きる", Section: "logs", Err: ErrInvalidLog},
+		{Line: 8, Text: "ミルクをあげた", Section: "logs", Err: ErrUnknownLine},
+		{Line: 13, Text: "2024/8/32(土)", Section: "date", Err: ErrInvalidDate},
+	}
+
+	t.Run("lenient", func(t *testing.T) {
+		data, got, err := ParseOptions{}.Parse(in)
+		if err != nil {
+			t.Fatalf("unexpected error returned: %v", err)
+		}
+		if len(data.Entries) != 2 || len(data.Entries[0].Logs) != 1 || len(data.Entries[1].Logs) != 1 {
+			t.Errorf("wrong entries: %v", data.Entries)
+		}
+		if diff := cmp.Diff(warnings, got, cmpopts.EquateErrors()); diff != "" {
+			t.Errorf("%s", diff)
+		}
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		_, _, err := ParseOptions{Strict: true}.Parse(in)
+		var perr *ParseError
+		if !errors.As(err, &perr) {
+			t.Fatalf("*ParseError must be returned: %v", err)
+		}
+		if diff := cmp.Diff(ParseError(warnings[0]), *perr, cmpopts.EquateErrors()); diff != "" {
+			t.Errorf("%s", diff)
+		}
+		if !errors.Is(err, ErrInvalidLog) {
+			t.Errorf("ErrInvalidLog must be wrapped: %v", err)
+		}
+	})
+
+	t.Run("no logs", func(t *testing.T) {
+		in := "2024/8/1(木)\nごふあ (0歳2か月10日)\n\nミルク合計　   0回 0ml\n\nメモ"
+		data, _, err := ParseOptions{Strict: true}.Parse("【ぴよログ】2024年8月\n----------\n" + in)
+		if err != nil {
+			t.Fatalf("unexpected error returned: %v", err)
+		}
+		if e := data.Entries[0]; len(e.Results) != 1 || e.Journal != "メモ" {
+			t.Errorf("wrong entry: %v", e)
+		}
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		_, _, err := ParseOptions{Strict: true}.Parse(`ごふあ (0歳1か月0日)`)
+		if !errors.Is(err, ErrUnknownFormat) {
+			t.Errorf("ErrUnknownFormat must be returned: %v", err)
+		}
+	})
+}
+
+func Test_ParseOptionsLocation(t *testing.T) {
+	in := `【ぴよログ】2023/12/31(水)
+ごふあ (0歳1か月1日)
+
+08:45 AM   ミルク 140ml   
+`
+	for _, name := range []string{"Asia/Tokyo", "America/Los_Angeles", "Europe/London", "UTC"} {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			t.Fatalf("unexpected error returned: %v", err)
+		}
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			for i := 0; i < 10; i++ {
+				data, _, err := ParseOptions{Location: loc}.Parse(in)
+				if err != nil {
+					t.Fatalf("unexpected error returned: %v", err)
+				}
+				entry := data.Entries[0]
+				if want := time.Date(2023, time.December, 31, 0, 0, 0, 0, loc); !entry.Date.Equal(want) {
+					t.Errorf("wrong date: want %v, got %v", want, entry.Date)
+				}
+				if want := time.Date(2023, time.December, 31, 8, 45, 0, 0, loc); !entry.Logs[0].CreatedAt().Equal(want) {
+					t.Errorf("wrong time: want %v, got %v", want, entry.Logs[0].CreatedAt())
+				}
+			}
+		})
+	}
+}
+
+func Test_ParseReaderEscaped(t *testing.T) {
+	entry := `----------\n2024/8/1(木)\nごふあ (0歳2か月10日)\n\n04:20 AM   ミルク 110ml   \n\nミルク合計　   1回 110ml\n\n`
+	n := maxLineSize/len(entry) + 1
+	in := `【ぴよログ】2024年8月\n` + strings.Repeat(entry, n) + `----------\n`
+	data, _, err := ParseOptions{Strict: true}.ParseReader(strings.NewReader(in))
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	if len(data.Entries) != n {
+		t.Errorf("wrong length: want %v, got %v", n, len(data.Entries))
+	}
+}
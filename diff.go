@@ -0,0 +1,201 @@
+package piyolog
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ChangeType represents a type of a change.
+type ChangeType int
+
+const (
+	ChangeAdded ChangeType = iota + 1
+	ChangeRemoved
+	ChangeModified
+)
+
+func (t ChangeType) String() string {
+	switch t {
+	case ChangeAdded:
+		return "added"
+	case ChangeRemoved:
+		return "removed"
+	case ChangeModified:
+		return "modified"
+	}
+	return ""
+}
+
+// Change represents a difference between two exports.
+type Change struct {
+	Type ChangeType `json:"type"`
+	Date time.Time  `json:"date"`
+	// Item is what is changed, which is "log", "journal" or a total such as
+	// "formula count" and "sleep total".
+	Item string `json:"item"`
+	// Old and New are the values before and after the change.
+	Old string `json:"old,omitempty"`
+	New string `json:"new,omitempty"`
+	// OldLog and NewLog are the logs before and after the change of a log.
+	OldLog Log `json:"old_log,omitempty"`
+	NewLog Log `json:"new_log,omitempty"`
+}
+
+func (c Change) String() string {
+	old, new := c.Old, c.New
+	if c.Item == "journal" {
+		old, new = strconv.Quote(old), strconv.Quote(new)
+	}
+	date := c.Date.Format(time.DateOnly)
+	switch c.Type {
+	case ChangeAdded:
+		return fmt.Sprintf("%s: added %s: %s", date, c.Item, new)
+	case ChangeRemoved:
+		return fmt.Sprintf("%s: removed %s: %s", date, c.Item, old)
+	}
+	return fmt.Sprintf("%s: modified %s: %s -> %s", date, c.Item, old, new)
+}
+
+// Diff returns the changes from old to new sorted by their dates.
+// The logs are matched by their dates, times and kinds.
+func Diff(old, new *Data) ([]Change, error) {
+	if old == nil || new == nil {
+		return nil, errors.New("piyolog: diff nil data")
+	}
+	olds, news := map[string]Entry{}, map[string]Entry{}
+	var dates []time.Time
+	for _, e := range old.Entries {
+		if _, ok := olds[dateKey(e.Date)]; !ok {
+			dates = append(dates, e.Date)
+		}
+		olds[dateKey(e.Date)] = e
+	}
+	for _, e := range new.Entries {
+		if _, ok := olds[dateKey(e.Date)]; !ok {
+			if _, ok := news[dateKey(e.Date)]; !ok {
+				dates = append(dates, e.Date)
+			}
+		}
+		news[dateKey(e.Date)] = e
+	}
+	sort.Slice(dates, func(i, j int) bool {
+		return dates[i].Before(dates[j])
+	})
+
+	var changes []Change
+	for _, date := range dates {
+		o, n := olds[dateKey(date)], news[dateKey(date)]
+		o.Date, n.Date = date, date
+		changes = append(changes, diffEntry(o, n)...)
+	}
+	return changes, nil
+}
+
+// diffEntry returns the changes from o to n of the same date.
+func diffEntry(o, n Entry) []Change {
+	var changes []Change
+	matched := make([]bool, len(o.Logs))
+	for _, l := range n.Logs {
+		i := matchLog(o.Logs, matched, l)
+		if i < 0 {
+			changes = append(changes, Change{
+				Type: ChangeAdded, Date: n.Date, Item: "log",
+				New: l.String(), NewLog: l,
+			})
+			continue
+		}
+		matched[i] = true
+		if ol := o.Logs[i]; ol.Content() != l.Content() || ol.Notes() != l.Notes() {
+			changes = append(changes, Change{
+				Type: ChangeModified, Date: n.Date, Item: "log",
+				Old: ol.String(), New: l.String(), OldLog: ol, NewLog: l,
+			})
+		}
+	}
+	for i, l := range o.Logs {
+		if !matched[i] {
+			changes = append(changes, Change{
+				Type: ChangeRemoved, Date: o.Date, Item: "log",
+				Old: l.String(), OldLog: l,
+			})
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].logTime().Before(changes[j].logTime())
+	})
+
+	if c, ok := diffValue(o.Date, "journal", o.Journal, n.Journal); ok {
+		changes = append(changes, c)
+	}
+	ot, nt := o.totals(), n.totals()
+	for _, item := range totalItems {
+		if c, ok := diffValue(o.Date, item, ot[item], nt[item]); ok {
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
+
+func (c Change) logTime() time.Time {
+	if c.NewLog != nil {
+		return c.NewLog.CreatedAt()
+	}
+	return c.OldLog.CreatedAt()
+}
+
+func diffValue(date time.Time, item, old, new string) (Change, bool) {
+	c := Change{Date: date, Item: item, Old: old, New: new}
+	switch {
+	case old == new:
+		return c, false
+	case old == "":
+		c.Type = ChangeAdded
+	case new == "":
+		c.Type = ChangeRemoved
+	default:
+		c.Type = ChangeModified
+	}
+	return c, true
+}
+
+// totalItems are the items of the totals named as the ones of Verify.
+var totalItems = []string{
+	"nursing left", "nursing right",
+	"formula count", "formula amount",
+	"pumped milk count", "pumped milk amount",
+	"solid count", "sleep total", "pee count", "poop count",
+}
+
+// totals returns the totals written in the results section by their items.
+// The totals which are not written are absent even if they are zero.
+func (e Entry) totals() map[string]string {
+	s := e.Summary
+	totals := map[string]string{}
+	for _, line := range e.Results {
+		label, _, _ := strings.Cut(line, logSeparator)
+		switch summaryKey(label) {
+		case summaryNursing:
+			totals["nursing left"] = s.NursingLeft.String()
+			totals["nursing right"] = s.NursingRight.String()
+		case summaryFormula:
+			totals["formula count"] = strconv.Itoa(s.FormulaCount)
+			totals["formula amount"] = s.FormulaVolume.String()
+		case summaryPumpedMilk:
+			totals["pumped milk count"] = strconv.Itoa(s.PumpedMilkCount)
+			totals["pumped milk amount"] = s.PumpedMilkVolume.String()
+		case summarySolid:
+			totals["solid count"] = strconv.Itoa(s.SolidCount)
+		case summarySleep:
+			totals["sleep total"] = s.SleepTotal.String()
+		case summaryPee:
+			totals["pee count"] = strconv.Itoa(s.PeeCount)
+		case summaryPoop:
+			totals["poop count"] = strconv.Itoa(s.PoopCount)
+		}
+	}
+	return totals
+}
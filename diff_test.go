@@ -0,0 +1,126 @@
+package piyolog
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_Diff(t *testing.T) {
+	old, err := Parse(`【ぴよログ】2023年3月
+----------
+2023/3/7(火)
+
+03:00   ミルク 90ml   
+
+ミルク合計　   1回 90ml
+
+----------
+2023/3/8(水)
+
+03:00   ミルク 90ml   
+06:00   おしっこ   
+07:00   うんち   
+
+ミルク合計　   1回 90ml
+おしっこ合計   1回
+うんち合計   1回
+
+よく寝た
+----------
+`)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	new, err := Parse(`【ぴよログ】2023年3月
+----------
+2023/3/8(水)
+
+03:00   ミルク 100ml   
+06:00   おしっこ   
+
+ミルク合計　   1回 100ml
+おしっこ合計   1回
+うんち合計   0回
+
+よく飲んだ
+----------
+2023/3/9(木)
+
+03:00   ミルク 90ml   
+----------
+`)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+
+	changes, err := Diff(old, new)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	var got []string
+	for _, c := range changes {
+		got = append(got, c.String())
+	}
+	want := []string{
+		"2023-03-07: removed log: 03:00 ミルク 90ml",
+		"2023-03-07: removed formula count: 1",
+		"2023-03-07: removed formula amount: 90ml",
+		"2023-03-08: modified log: 03:00 ミルク 90ml -> 03:00 ミルク 100ml",
+		"2023-03-08: removed log: 07:00 うんち",
+		`2023-03-08: modified journal: "よく寝た" -> "よく飲んだ"`,
+		"2023-03-08: modified formula amount: 90ml -> 100ml",
+		"2023-03-08: modified poop count: 1 -> 0",
+		"2023-03-09: added log: 03:00 ミルク 90ml",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Diff failure: %s", diff)
+	}
+
+	if changes, _ := Diff(old, old); len(changes) != 0 {
+		t.Errorf("no changes must be returned for the same data: %v", changes)
+	}
+	if _, err := Diff(nil, new); err == nil {
+		t.Errorf("error must be returned for nil data")
+	}
+
+	b, err := json.Marshal(changes)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	var decoded []Change
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	if diff := cmp.Diff(changes, decoded, equateLogItem); diff != "" {
+		t.Errorf("JSON failure: %s", diff)
+	}
+}
+
+func Test_DiffJSON(t *testing.T) {
+	data, err := Parse(`【ぴよログ】2023/12/31(日)
+
+08:45   ミルク 140ml   
+
+ミルク合計　   1回 140ml
+`)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	b, err := json.Marshal(data)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	var decoded Data
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	changes, err := Diff(data, &decoded)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("no changes must be returned for the JSON of the data: %v", changes)
+	}
+}
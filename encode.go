@@ -0,0 +1,118 @@
+package piyolog
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Marshal returns the PiyoLog export data of d.
+func Marshal(d *Data) ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := d.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// WriteTo writes the PiyoLog export data of d to w.
+// It writes monthly data if d.Monthly is true or d has more than one entry,
+// otherwise daily data. Monthly data without entries cannot be written since
+// its header needs the month.
+func (d Data) WriteTo(w io.Writer) (int64, error) {
+	l := lookupLocale(d.Tag)
+	if l == nil {
+		return 0, ErrUnknownFormat
+	}
+	if d.Monthly && len(d.Entries) == 0 {
+		return 0, errors.New("piyolog: write monthly data without entries")
+	}
+	var buf bytes.Buffer
+	var n int64
+	flush := func() error {
+		m, err := buf.WriteTo(w)
+		n += m
+		return err
+	}
+
+	if !d.Monthly && len(d.Entries) <= 1 {
+		for _, e := range d.Entries {
+			fmt.Fprintf(&buf, "%s%s\n", l.Header, l.formatDate(e.Date))
+			d.writeEntry(&buf, l, e)
+		}
+		return n, flush()
+	}
+
+	fmt.Fprintf(&buf, "%s%s\n", l.Header, d.Entries[0].Date.Format(l.MonthLayout))
+	fmt.Fprintln(&buf, piyologSeparator)
+	for _, e := range d.Entries {
+		fmt.Fprintln(&buf, l.formatDate(e.Date))
+		d.writeEntry(&buf, l, e)
+		fmt.Fprintf(&buf, "\n%s\n", piyologSeparator)
+		// write entry by entry not to buffer the whole export data.
+		if err := flush(); err != nil {
+			return n, err
+		}
+	}
+	return n, flush()
+}
+
+// writeEntry writes the entry except for its date.
+func (d Data) writeEntry(buf *bytes.Buffer, l *locale, e Entry) {
+	if e.Baby != nil {
+		fmt.Fprintln(buf, l.formatBaby(e.Date, e.Baby))
+	}
+	if len(e.Logs) > 0 {
+		fmt.Fprintln(buf)
+		for _, l := range e.Logs {
+			fmt.Fprintln(buf, d.formatLog(l))
+		}
+	}
+	if len(e.Results) > 0 {
+		fmt.Fprintln(buf)
+		for _, line := range e.Results {
+			fmt.Fprintln(buf, line)
+		}
+	}
+	if e.Journal != "" {
+		fmt.Fprintln(buf)
+		fmt.Fprintln(buf, e.Journal)
+	}
+}
+
+func (l *locale) formatBaby(date time.Time, b *Baby) string {
+	y, m, days := age(b.DateOfBirth, date)
+	return fmt.Sprintf("%s (%s)", b.Name, fmt.Sprintf(l.Age, y, m, days))
+}
+
+func (d Data) formatLog(l Log) string {
+	layout := "15:04"
+	if d.Hour12 {
+		layout = "03:04 PM"
+	}
+	return strings.Join([]string{
+		l.CreatedAt().Format(layout),
+		strings.TrimSpace(l.Type() + " " + l.Content()),
+		l.Notes(),
+	}, logSeparator)
+}
+
+// age returns the years, months and days from the date of birth to the date,
+// which are the inverse of the ones used to calculate the date of birth.
+func age(birth, date time.Time) (y, m, d int) {
+	birth, date = civilDate(birth), civilDate(date)
+	if date.Before(birth) {
+		return 0, 0, 0
+	}
+	months := (date.Year()-birth.Year())*12 + int(date.Month()-birth.Month())
+	for ; months > 0; months-- {
+		if !date.AddDate(0, -months, 0).Before(birth) {
+			break
+		}
+	}
+	base := date.AddDate(0, -months, 0)
+	return months / 12, months % 12, int(base.Sub(birth).Hours() / 24)
+}
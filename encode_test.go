@@ -0,0 +1,196 @@
+package piyolog
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"golang.org/x/text/language"
+)
+
+func Test_Marshal(t *testing.T) {
+	tests := []string{
+		`【ぴよログ】2023/3/8(水)
+ごふあ (0歳0か月21日)
+
+02:55   起きる (3時間35分)   
+03:00   ミルク 90ml   
+07:20   うんち (多め/やわらかめ)   
+08:20   母乳 左 10分 / 右 10分   たくさん飲んだ
+
+母乳合計　　   左 10分 / 右 10分
+ミルク合計　   1回 90ml
+睡眠合計　　   2時間55分
+おしっこ合計   0回
+うんち合計　   1回
+
+今日もとっても元気に過ごしていた
+
+パパより
+`,
+		`[PiyoLog]Sun, Dec 31, 2023
+Gofua (1y1m1d)
+
+08:45 AM   Formula 140ml   drank a lot
+01:55 PM   Sleep   
+02:45 PM   Wake-up (0h50m)   
+
+Formula total   1 time(s) 140ml
+`,
+		`【ぴよログ】2024年8月
+----------
+2024/8/1(木)
+ごふあ (0歳2か月10日)
+
+04:15 AM   起きる (8時間40分)   
+04:20 AM   ミルク 110ml   
+
+ミルク合計　   1回 110ml
+
+----------
+2024/8/31(土)
+ごふあ (0歳3か月9日)
+
+04:20 AM   ミルク 110ml   
+
+ミルク合計　   1回 110ml
+
+お食い初めだよ
+
+----------
+`,
+		`【ぴよログ】2024/8/1(木)
+ごふあ (0歳2か月10日)
+
+ミルク合計　   0回 0ml
+
+メモ
+`,
+		`[PiyoLog]Feb 2024
+----------
+Thu, Feb 29, 2024
+Gofua (0y0m22d)
+
+11:45   Nursing Left 10m   
+
+----------
+`,
+	}
+	for _, in := range tests {
+		t.Run(in, func(t *testing.T) {
+			data, err := Parse(in)
+			if err != nil {
+				t.Fatalf("unexpected error returned: %v", err)
+			}
+			out, err := Marshal(data)
+			if err != nil {
+				t.Fatalf("unexpected error returned: %v", err)
+			}
+			if diff := cmp.Diff(in, string(out)); diff != "" {
+				t.Errorf("%s", diff)
+			}
+		})
+	}
+}
+
+func Test_WriteTo(t *testing.T) {
+	for _, tt := range parseTests {
+		if len(tt.out.Entries) == 0 {
+			continue
+		}
+		t.Run(tt.in, func(t *testing.T) {
+			data, err := Parse(tt.in)
+			if err != nil {
+				t.Fatalf("unexpected error returned: %v", err)
+			}
+			var buf bytes.Buffer
+			n, err := data.WriteTo(&buf)
+			if err != nil {
+				t.Fatalf("unexpected error returned: %v", err)
+			}
+			if n != int64(buf.Len()) {
+				t.Errorf("wrong length: want %v, got %v", buf.Len(), n)
+			}
+			got, err := Parse(buf.String())
+			if err != nil {
+				t.Fatalf("unexpected error returned: %v", err)
+			}
+			if diff := cmp.Diff(data, got, cmpopts.EquateComparable(LogItem{}, language.Tag{}), cmpopts.IgnoreUnexported(Entry{})); diff != "" {
+				t.Errorf("%s", diff)
+			}
+		})
+	}
+}
+
+func Test_WriteToData(t *testing.T) {
+	entries := []Entry{
+		{
+			Date: time.Date(2024, time.August, 1, 0, 0, 0, 0, piyoLoc),
+			Logs: []Log{
+				FormulaLog{
+					LogItem: LogItem{
+						typ:       "ミルク",
+						content:   "110ml",
+						createdAt: time.Date(2024, time.August, 1, 4, 20, 0, 0, piyoLoc),
+					},
+					Volume: Volume{Value: 110, Unit: Milliliter},
+				},
+			},
+		},
+		{
+			Date:    time.Date(2024, time.August, 2, 0, 0, 0, 0, piyoLoc),
+			Results: []string{"ミルク合計　   0回 0ml"},
+			Summary: DailySummary{
+				FormulaVolume: Volume{Value: 0, Unit: Milliliter},
+			},
+			Journal: "メモ",
+		},
+	}
+
+	t.Run("entries of daily data", func(t *testing.T) {
+		data := &Data{Tag: language.Japanese, Entries: entries}
+		out, err := Marshal(data)
+		if err != nil {
+			t.Fatalf("unexpected error returned: %v", err)
+		}
+		got, warnings, err := ParseOptions{Strict: true}.Parse(string(out))
+		if err != nil {
+			t.Fatalf("unexpected error returned: %v: %v", err, warnings)
+		}
+		want := &Data{Tag: language.Japanese, Monthly: true, Entries: entries}
+		if diff := cmp.Diff(want, got, cmpopts.EquateComparable(LogItem{}, language.Tag{}), cmpopts.IgnoreUnexported(Entry{})); diff != "" {
+			t.Errorf("%s", diff)
+		}
+	})
+
+	t.Run("monthly data without entries", func(t *testing.T) {
+		if _, err := Marshal(&Data{Tag: language.Japanese, Monthly: true}); err == nil {
+			t.Errorf("error must be returned")
+		}
+	})
+}
+
+func Test_age(t *testing.T) {
+	tests := []struct {
+		birth   time.Time
+		date    time.Time
+		y, m, d int
+	}{
+		{time.Date(2024, time.February, 7, 0, 0, 0, 0, piyoLoc), time.Date(2024, time.February, 29, 0, 0, 0, 0, piyoLoc), 0, 0, 22},
+		{time.Date(2023, time.November, 30, 0, 0, 0, 0, piyoLoc), time.Date(2023, time.December, 31, 0, 0, 0, 0, piyoLoc), 0, 1, 1},
+		{time.Date(2022, time.November, 30, 0, 0, 0, 0, piyoLoc), time.Date(2024, time.March, 1, 0, 0, 0, 0, piyoLoc), 1, 3, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.date.String(), func(t *testing.T) {
+			y, m, d := age(tt.birth, tt.date)
+			if y != tt.y || m != tt.m || d != tt.d {
+				t.Errorf("wrong age: want %d/%d/%d, got %d/%d/%d", tt.y, tt.m, tt.d, y, m, d)
+			}
+			if got := tt.date.AddDate(-y, -m, -d); !got.Equal(tt.birth) {
+				t.Errorf("wrong date of birth: want %v, got %v", tt.birth, got)
+			}
+		})
+	}
+}
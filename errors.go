@@ -0,0 +1,42 @@
+package piyolog
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrUnknownFormat is returned when the head of the data is not the one of PiyoLog export data.
+	ErrUnknownFormat = errors.New("unknown format")
+	// ErrInvalidDate is returned when a line which must be a date cannot be parsed.
+	ErrInvalidDate = errors.New("invalid date")
+	// ErrInvalidLog is returned when a line which looks like a log cannot be parsed.
+	ErrInvalidLog = errors.New("invalid log")
+	// ErrInvalidContent is returned when the content of a log cannot be parsed.
+	ErrInvalidContent = errors.New("invalid content")
+	// ErrUnknownLine is returned when a line in the logs section is not a log.
+	ErrUnknownLine = errors.New("unknown line")
+)
+
+// ParseError describes a line of the export data that cannot be parsed.
+type ParseError struct {
+	Line    int    // 1-based line number
+	Text    string // raw line
+	Section string // section of the entry, such as "date" and "logs"
+	Err     error  // reason of the failure
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("piyolog: line %d: %s in %s section: %q", e.Line, e.Err, e.Section, e.Text)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ParseWarning describes a line of the export data skipped in lenient mode.
+type ParseWarning ParseError
+
+func (w ParseWarning) String() string {
+	return fmt.Sprintf("line %d: %s in %s section: %q", w.Line, w.Err, w.Section, w.Text)
+}
@@ -0,0 +1,204 @@
+package piyolog
+
+import (
+	"strings"
+)
+
+// Amount represents an amount of pee or poop.
+type Amount int
+
+const (
+	AmountUnknown Amount = iota
+	AmountLittle
+	AmountNormal
+	AmountLot
+)
+
+func (a Amount) String() string {
+	switch a {
+	case AmountLittle:
+		return "little"
+	case AmountNormal:
+		return "normal"
+	case AmountLot:
+		return "lot"
+	}
+	return ""
+}
+
+// Consistency represents a consistency of poop.
+type Consistency int
+
+const (
+	ConsistencyUnknown Consistency = iota
+	ConsistencyHard
+	ConsistencyNormal
+	ConsistencySoft
+	ConsistencyLoose
+	ConsistencyDiarrhea
+)
+
+func (c Consistency) String() string {
+	switch c {
+	case ConsistencyHard:
+		return "hard"
+	case ConsistencyNormal:
+		return "normal"
+	case ConsistencySoft:
+		return "soft"
+	case ConsistencyLoose:
+		return "loose"
+	case ConsistencyDiarrhea:
+		return "diarrhea"
+	}
+	return ""
+}
+
+// IsLoose reports whether c is loose or diarrhea.
+func (c Consistency) IsLoose() bool {
+	return c == ConsistencyLoose || c == ConsistencyDiarrhea
+}
+
+// Color represents a color of poop.
+type Color int
+
+const (
+	ColorUnknown Color = iota
+	ColorYellow
+	ColorGreen
+	ColorBrown
+	ColorRed
+	ColorBlack
+	ColorWhite
+)
+
+func (c Color) String() string {
+	switch c {
+	case ColorYellow:
+		return "yellow"
+	case ColorGreen:
+		return "green"
+	case ColorBrown:
+		return "brown"
+	case ColorRed:
+		return "red"
+	case ColorBlack:
+		return "black"
+	case ColorWhite:
+		return "white"
+	}
+	return ""
+}
+
+// IsWarning reports whether c is a color pediatricians ask about,
+// which are white and black.
+func (c Color) IsWarning() bool {
+	return c == ColorWhite || c == ColorBlack
+}
+
+// The descriptors written in the content and notes of pee and poop logs.
+// "ふつう" and "Normal" are not listed since they are used for both the amount
+// and the consistency.
+var (
+	amountWords = map[string]Amount{
+		"少なめ": AmountLittle, "少量": AmountLittle, "little": AmountLittle, "small": AmountLittle,
+		"多め": AmountLot, "多量": AmountLot, "lot": AmountLot, "a lot": AmountLot, "large": AmountLot,
+	}
+	consistencyWords = map[string]Consistency{
+		"硬め": ConsistencyHard, "かため": ConsistencyHard, "hard": ConsistencyHard,
+		"やわらかめ": ConsistencySoft, "柔らかめ": ConsistencySoft, "soft": ConsistencySoft,
+		"ゆるめ": ConsistencyLoose, "ゆるい": ConsistencyLoose, "loose": ConsistencyLoose,
+		"下痢": ConsistencyDiarrhea, "水っぽい": ConsistencyDiarrhea, "diarrhea": ConsistencyDiarrhea, "watery": ConsistencyDiarrhea,
+	}
+	colorWords = map[string]Color{
+		"黄": ColorYellow, "黄色": ColorYellow, "yellow": ColorYellow,
+		"緑": ColorGreen, "緑色": ColorGreen, "green": ColorGreen,
+		"茶": ColorBrown, "茶色": ColorBrown, "brown": ColorBrown,
+		"赤": ColorRed, "赤色": ColorRed, "red": ColorRed,
+		"黒": ColorBlack, "黒色": ColorBlack, "black": ColorBlack,
+		"白": ColorWhite, "白色": ColorWhite, "white": ColorWhite,
+	}
+	normalWords = []string{"ふつう", "普通", "normal"}
+)
+
+// descriptors returns the lower-cased words in str divided by slashes and
+// commas, such as "(少なめ/ふつう/緑)". Spaces do not divide the words, so
+// that a word like "a lot" is kept.
+func descriptors(str string) []string {
+	str = strings.Trim(strings.TrimSpace(str), "()")
+	var words []string
+	for _, w := range strings.FieldsFunc(str, func(r rune) bool {
+		return r == '/' || r == '、' || r == ','
+	}) {
+		if w = strings.ToLower(strings.TrimSpace(w)); w != "" {
+			words = append(words, w)
+		}
+	}
+	return words
+}
+
+// excretion returns the descriptors of pee or poop in the content and notes.
+// A normal word is taken as the amount if the amount is not described yet,
+// otherwise as the consistency, following the order PiyoLog writes them.
+func excretion(i LogItem) (amount Amount, consistency Consistency, color Color) {
+	for _, w := range append(descriptors(i.content), descriptors(i.notes)...) {
+		if v, ok := amountWords[w]; ok && amount == AmountUnknown {
+			amount = v
+		} else if v, ok := consistencyWords[w]; ok && consistency == ConsistencyUnknown {
+			consistency = v
+		} else if v, ok := colorWords[w]; ok && color == ColorUnknown {
+			color = v
+		} else if isNormalWord(w) {
+			if amount == AmountUnknown {
+				amount = AmountNormal
+			} else if consistency == ConsistencyUnknown {
+				consistency = ConsistencyNormal
+			}
+		}
+	}
+	return amount, consistency, color
+}
+
+func isNormalWord(w string) bool {
+	for _, n := range normalWords {
+		if w == n {
+			return true
+		}
+	}
+	return false
+}
+
+// LooseStools returns the runs of at least n consecutive poops which are
+// loose or diarrhea across the entries.
+func (d Data) LooseStools(n int) [][]PoopLog {
+	var (
+		runs [][]PoopLog
+		run  []PoopLog
+	)
+	flush := func() {
+		if len(run) > 0 && len(run) >= n {
+			runs = append(runs, run)
+		}
+		run = nil
+	}
+	for p := range LogsOf[PoopLog](&d) {
+		if !p.Consistency.IsLoose() {
+			flush()
+			continue
+		}
+		run = append(run, p)
+	}
+	flush()
+	return runs
+}
+
+// ColorWarnings returns the poops whose color is a warning.
+func (d Data) ColorWarnings() []PoopLog {
+	var ps []PoopLog
+	for p := range LogsOf[PoopLog](&d) {
+		if p.Color.IsWarning() {
+			ps = append(ps, p)
+		}
+	}
+	return ps
+}
@@ -0,0 +1,49 @@
+package piyolog
+
+import (
+	"testing"
+)
+
+func Test_LooseStools(t *testing.T) {
+	in := `【ぴよログ】2023/3/8(水)
+
+07:20   うんち (多め/ゆるめ)   
+09:00   おしっこ   
+11:20   うんち (ふつう/下痢/黄)   
+15:20   うんち (ふつう/ふつう/黒)   
+18:20   うんち (少なめ/ゆるめ/白)   
+`
+	data, err := Parse(in)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+
+	tests := []struct {
+		n    int
+		want []int
+	}{
+		{n: 1, want: []int{2, 1}},
+		{n: 2, want: []int{2}},
+		{n: 3, want: nil},
+	}
+	for _, tt := range tests {
+		var got []int
+		for _, run := range data.LooseStools(tt.n) {
+			got = append(got, len(run))
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("LooseStools(%d) returns runs of %v, want %v", tt.n, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("LooseStools(%d) returns runs of %v, want %v", tt.n, got, tt.want)
+			}
+		}
+	}
+
+	ws := data.ColorWarnings()
+	if len(ws) != 2 || ws[0].Color != ColorBlack || ws[1].Color != ColorWhite {
+		t.Errorf("ColorWarnings returns %v", ws)
+	}
+}
@@ -0,0 +1,194 @@
+// Package csv writes PiyoLog data as CSV or TSV for spreadsheets.
+package csv
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/kaneshin/piyolog"
+	"golang.org/x/text/language"
+)
+
+// The English headers are written unless the locale of the data has the
+// headers, see piyolog.Locale.
+var (
+	logHeader     = []string{"Date", "Time", "Baby", "Type", "Amount", "Unit", "Duration (min)", "Temperature", "Left (min)", "Right (min)", "Notes"}
+	summaryHeader = []string{"Date", "Baby", "Nursing left (min)", "Nursing right (min)", "Formula count", "Formula amount", "Formula unit",
+		"Pumped milk count", "Pumped milk amount", "Pumped milk unit", "Solid count", "Sleep (min)", "Pee count", "Poop count"}
+)
+
+// A Writer writes logs and daily summaries of PiyoLog data as records.
+type Writer struct {
+	// Comma is the field delimiter. It is set to ',' by NewWriter.
+	// Set it to '\t' to write TSV.
+	Comma rune
+	// Tag is the language of the headers and the types of the logs.
+	// If it is language.Und, the headers are localized by the tag of the data
+	// and the types are the names of the kinds.
+	Tag language.Tag
+	w   io.Writer
+}
+
+// NewWriter returns a new Writer that writes to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{
+		Comma: ',',
+		w:     w,
+	}
+}
+
+// WriteLogs writes one record per log of d with a header localized by w.Tag
+// or d.Tag.
+func (w *Writer) WriteLogs(d *piyolog.Data) error {
+	cw := w.csvWriter()
+	l, _ := piyolog.LookupLocale(w.tag(d))
+	if err := cw.Write(header(l.LogHeader, logHeader)); err != nil {
+		return err
+	}
+	for _, e := range d.Entries {
+		for _, l := range e.Logs {
+			if err := cw.Write(w.logRecord(e, l)); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteSummaries writes one record per entry of d with a header localized by
+// w.Tag or d.Tag.
+func (w *Writer) WriteSummaries(d *piyolog.Data) error {
+	cw := w.csvWriter()
+	l, _ := piyolog.LookupLocale(w.tag(d))
+	if err := cw.Write(header(l.SummaryHeader, summaryHeader)); err != nil {
+		return err
+	}
+	for _, e := range d.Entries {
+		s := e.Summary
+		record := []string{
+			e.Date.Format(time.DateOnly),
+			babyName(e),
+			minutes(s.NursingLeft),
+			minutes(s.NursingRight),
+			strconv.Itoa(s.FormulaCount),
+			value(s.FormulaVolume.Value),
+			s.FormulaVolume.Unit.String(),
+			strconv.Itoa(s.PumpedMilkCount),
+			value(s.PumpedMilkVolume.Value),
+			s.PumpedMilkVolume.Unit.String(),
+			strconv.Itoa(s.SolidCount),
+			minutes(s.SleepTotal),
+			strconv.Itoa(s.PeeCount),
+			strconv.Itoa(s.PoopCount),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func (w *Writer) csvWriter() *csv.Writer {
+	cw := csv.NewWriter(w.w)
+	cw.Comma = w.Comma
+	return cw
+}
+
+// header returns the localized header if it has the names of all columns of
+// the English one.
+func header(localized, en []string) []string {
+	if len(localized) != len(en) {
+		return en
+	}
+	return localized
+}
+
+// tag returns the language of the headers.
+func (w *Writer) tag(d *piyolog.Data) language.Tag {
+	if w.Tag != language.Und {
+		return w.Tag
+	}
+	return d.Tag
+}
+
+func (w *Writer) logRecord(e piyolog.Entry, l piyolog.Log) []string {
+	typ := l.Kind().String()
+	if w.Tag != language.Und {
+		typ = l.Kind().Localized(w.Tag)
+	}
+	if l.Kind() == piyolog.KindOther {
+		typ = l.Type()
+	}
+	var amount, unit, duration, temperature, left, right string
+	switch v := l.(type) {
+	case piyolog.NursingLog:
+		amount, unit = volume(v.Volume)
+		left, right = minutes(v.Left), minutes(v.Right)
+	case piyolog.FormulaLog:
+		amount, unit = volume(v.Volume)
+	case piyolog.WakeUpLog:
+		duration = minutes(v.Duration)
+	case piyolog.BodyTemperatureLog:
+		temperature, unit = value(v.Temperature.Value), v.Temperature.Unit.String()
+	case piyolog.PumpedMilkLog:
+		amount, unit = volume(v.Volume)
+	case piyolog.DrinkLog:
+		amount, unit = volume(v.Volume)
+	case piyolog.HeightLog:
+		amount, unit = value(v.Height), v.Unit
+	case piyolog.WeightLog:
+		amount, unit = value(v.Weight), v.Unit
+	case piyolog.HeadCircumferenceLog:
+		amount, unit = value(v.Circumference), v.Unit
+	case piyolog.WalkLog:
+		if v.Duration > 0 {
+			duration = minutes(v.Duration)
+		}
+	case piyolog.PumpingLog:
+		amount, unit = volume(v.Volume)
+		if v.Left > 0 || v.Right > 0 {
+			left, right = minutes(v.Left), minutes(v.Right)
+		}
+	}
+	return []string{
+		e.Date.Format(time.DateOnly),
+		l.CreatedAt().Format("15:04"),
+		babyName(e),
+		typ,
+		amount,
+		unit,
+		duration,
+		temperature,
+		left,
+		right,
+		l.Notes(),
+	}
+}
+
+func babyName(e piyolog.Entry) string {
+	if e.Baby == nil {
+		return ""
+	}
+	return e.Baby.Name
+}
+
+func minutes(d time.Duration) string {
+	return strconv.FormatFloat(d.Minutes(), 'f', -1, 64)
+}
+
+func value(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// volume returns the value and the unit of the volume, or empty strings if
+// the volume is not written.
+func volume(v piyolog.Volume) (string, string) {
+	if v.Unit == piyolog.VolumeUnitUnknown {
+		return "", ""
+	}
+	return value(v.Value), v.Unit.String()
+}
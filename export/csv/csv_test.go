@@ -0,0 +1,126 @@
+package csv
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kaneshin/piyolog"
+	"golang.org/x/text/language"
+)
+
+const daily = `【ぴよログ】2023/3/8(水)
+ごふあ (0歳0か月21日)
+
+02:55   起きる (3時間35分)   
+03:00   ミルク 90ml   
+08:20   母乳 左 10分 / 右 7分30秒   たくさん飲んだ
+14:30   体温 36.5°C   
+
+母乳合計　　   左 10分 / 右 7分30秒
+ミルク合計　   1回 90ml
+睡眠合計　　   2時間55分
+おしっこ合計   0回
+うんち合計　   0回
+`
+
+func Test_WriteLogs(t *testing.T) {
+	data, err := piyolog.Parse(daily)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	tests := []struct {
+		comma rune
+		tag   language.Tag
+		out   string
+	}{
+		{
+			comma: ',',
+			out: `日付,時刻,名前,種類,量,単位,時間(分),体温,左(分),右(分),メモ
+2023-03-08,02:55,ごふあ,wake-up,,,215,,,,
+2023-03-08,03:00,ごふあ,formula,90,ml,,,,,
+2023-03-08,08:20,ごふあ,nursing,,,,,10,7.5,たくさん飲んだ
+2023-03-08,14:30,ごふあ,body-temperature,,°C,,36.5,,,
+`,
+		},
+		{
+			comma: '\t',
+			out: "日付\t時刻\t名前\t種類\t量\t単位\t時間(分)\t体温\t左(分)\t右(分)\tメモ\n" +
+				"2023-03-08\t02:55\tごふあ\twake-up\t\t\t215\t\t\t\t\n" +
+				"2023-03-08\t03:00\tごふあ\tformula\t90\tml\t\t\t\t\t\n" +
+				"2023-03-08\t08:20\tごふあ\tnursing\t\t\t\t\t10\t7.5\tたくさん飲んだ\n" +
+				"2023-03-08\t14:30\tごふあ\tbody-temperature\t\t°C\t\t36.5\t\t\t\n",
+		},
+		{
+			comma: ',',
+			tag:   language.English,
+			out: `Date,Time,Baby,Type,Amount,Unit,Duration (min),Temperature,Left (min),Right (min),Notes
+2023-03-08,02:55,ごふあ,Wake-up,,,215,,,,
+2023-03-08,03:00,ごふあ,Formula,90,ml,,,,,
+2023-03-08,08:20,ごふあ,Nursing,,,,,10,7.5,たくさん飲んだ
+2023-03-08,14:30,ごふあ,Body Temp.,,°C,,36.5,,,
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.comma)+tt.tag.String(), func(t *testing.T) {
+			var buf bytes.Buffer
+			w := NewWriter(&buf)
+			w.Comma = tt.comma
+			w.Tag = tt.tag
+			if err := w.WriteLogs(data); err != nil {
+				t.Fatalf("unexpected error returned: %v", err)
+			}
+			if diff := cmp.Diff(tt.out, buf.String()); diff != "" {
+				t.Errorf("%s", diff)
+			}
+		})
+	}
+}
+
+func Test_WriteSummaries(t *testing.T) {
+	data, err := piyolog.Parse(daily)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := NewWriter(&buf).WriteSummaries(data); err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	out := `日付,名前,母乳 左(分),母乳 右(分),ミルク 回数,ミルク 量,ミルク 単位,搾母乳 回数,搾母乳 量,搾母乳 単位,離乳食 回数,睡眠(分),おしっこ 回数,うんち 回数
+2023-03-08,ごふあ,10,7.5,1,90,ml,0,0,,0,175,0,0
+`
+	if diff := cmp.Diff(out, buf.String()); diff != "" {
+		t.Errorf("%s", diff)
+	}
+}
+
+func Test_WriteSummariesHeader(t *testing.T) {
+	data, err := piyolog.Parse(daily)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	tests := []struct {
+		tag    language.Tag
+		header string
+	}{
+		{tag: language.Japanese, header: "日付,名前,母乳 左(分)"},
+		{tag: language.English, header: "Date,Baby,Nursing left (min)"},
+		// the locale which is not registered has no headers.
+		{tag: language.Korean, header: "Date,Baby,Nursing left (min)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag.String(), func(t *testing.T) {
+			var buf bytes.Buffer
+			w := NewWriter(&buf)
+			w.Tag = tt.tag
+			if err := w.WriteSummaries(data); err != nil {
+				t.Fatalf("unexpected error returned: %v", err)
+			}
+			if !strings.HasPrefix(buf.String(), tt.header+",") {
+				t.Errorf("wrong header: %s", buf.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package piyolog
+
+import (
+	"slices"
+	"sort"
+	"time"
+)
+
+// DefaultFeedingGap is the gap of Feedings used if it is not positive.
+const DefaultFeedingGap = 30 * time.Minute
+
+// Feeding represents the feeds of one feeding, such as nursing followed by
+// formula.
+type Feeding struct {
+	// Start and End are the times of the first and the last feeds.
+	Start, End time.Time
+	// Logs are the formula and nursing logs in chronological order.
+	Logs []Log
+}
+
+// Feedings returns the feedings of the formula and nursing logs selected by
+// the filters in chronological order. A feed within gap of the previous one
+// belongs to the same feeding.
+func Feedings(d *Data, gap time.Duration, filters ...Filter) []Feeding {
+	if gap <= 0 {
+		gap = DefaultFeedingGap
+	}
+	var feeds []Log
+	for _, l := range d.Logs(append(slices.Clip(filters), OfKind(KindFormula, KindNursing))...) {
+		feeds = append(feeds, l)
+	}
+	sort.SliceStable(feeds, func(i, j int) bool {
+		return feeds[i].CreatedAt().Before(feeds[j].CreatedAt())
+	})
+	var feedings []Feeding
+	for _, l := range feeds {
+		t := l.CreatedAt()
+		if n := len(feedings); n > 0 && t.Sub(feedings[n-1].End) <= gap {
+			feedings[n-1].End = t
+			feedings[n-1].Logs = append(feedings[n-1].Logs, l)
+			continue
+		}
+		feedings = append(feedings, Feeding{Start: t, End: t, Logs: []Log{l}})
+	}
+	return feedings
+}
@@ -0,0 +1,45 @@
+package piyolog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_Feedings(t *testing.T) {
+	data, err := Parse(`【ぴよログ】2023/3/8(水)
+
+03:00   母乳 左 10分   
+03:20   ミルク 60ml   
+03:30   おしっこ   
+06:00   ミルク 90ml   
+06:45   ミルク 30ml   
+`)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	at := func(hour, min int) time.Time {
+		return time.Date(2023, time.March, 8, hour, min, 0, 0, piyoLoc)
+	}
+	type feeding struct {
+		Start, End time.Time
+		Logs       int
+	}
+	var got []feeding
+	for _, f := range Feedings(data, 0) {
+		got = append(got, feeding{f.Start, f.End, len(f.Logs)})
+	}
+	want := []feeding{
+		{at(3, 0), at(3, 20), 2},
+		{at(6, 0), at(6, 0), 1},
+		{at(6, 45), at(6, 45), 1},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Feedings failure: %s", diff)
+	}
+
+	if n := len(Feedings(data, time.Hour, Between(at(4, 0), time.Time{}))); n != 1 {
+		t.Errorf("Feedings must be filtered and grouped by the gap: %d", n)
+	}
+}
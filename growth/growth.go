@@ -0,0 +1,295 @@
+// Package growth evaluates the height, weight and head circumference logs of
+// PiyoLog data against the WHO Child Growth Standards.
+//
+// The embedded tables hold the monthly LMS parameters of the standards from
+// birth to 60 months, which are interpolated linearly by the age in days.
+package growth
+
+import (
+	"bufio"
+	"embed"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/kaneshin/piyolog"
+)
+
+//go:embed tables/*.txt
+var tables embed.FS
+
+var (
+	// ErrUnknownSex is returned when the sex of the baby is not set.
+	ErrUnknownSex = errors.New("growth: unknown sex")
+	// ErrOutOfRange is returned when the age is out of the range of the tables.
+	ErrOutOfRange = errors.New("growth: age out of range")
+	// ErrUnknownUnit is returned when the unit of a measurement is not supported.
+	ErrUnknownUnit = errors.New("growth: unknown unit")
+)
+
+// Indicator represents an indicator of the standards.
+type Indicator int
+
+const (
+	WeightForAge Indicator = iota + 1
+	// LengthForAge is the length-for-age until 24 months and the
+	// height-for-age after that, since the standards measure children lying
+	// until 2 years and standing after that.
+	LengthForAge
+	HeadCircumferenceForAge
+)
+
+func (i Indicator) String() string {
+	switch i {
+	case WeightForAge:
+		return "weight-for-age"
+	case LengthForAge:
+		return "length-for-age"
+	case HeadCircumferenceForAge:
+		return "head-circumference-for-age"
+	}
+	return ""
+}
+
+// table returns the name of the table of the indicator at the age in months.
+func (i Indicator) table(month float64) string {
+	switch i {
+	case WeightForAge:
+		return "wfa"
+	case LengthForAge:
+		if month >= 24 {
+			return "hfa"
+		}
+		return "lfa"
+	case HeadCircumferenceForAge:
+		return "hcfa"
+	}
+	return ""
+}
+
+// daysPerMonth is the average days of a month used by the standards.
+const daysPerMonth = 30.4375
+
+type lms struct {
+	L, M, S float64
+}
+
+// zScore returns the z-score of x by the LMS method.
+func (p lms) zScore(x float64) float64 {
+	if p.L == 0 {
+		return math.Log(x/p.M) / p.S
+	}
+	return (math.Pow(x/p.M, p.L) - 1) / (p.L * p.S)
+}
+
+// value returns the measurement of the z-score z.
+func (p lms) value(z float64) float64 {
+	if p.L == 0 {
+		return p.M * math.Exp(p.S*z)
+	}
+	return p.M * math.Pow(1+p.L*p.S*z, 1/p.L)
+}
+
+// table holds the monthly LMS parameters from the first month.
+type table struct {
+	first int
+	rows  []lms
+}
+
+var loaded = map[string]table{}
+
+func init() {
+	for _, ind := range []string{"wfa", "lfa", "hfa", "hcfa"} {
+		for _, sex := range []string{"boys", "girls"} {
+			name := ind + "_" + sex
+			t, err := loadTable("tables/" + name + ".txt")
+			if err != nil {
+				panic(err)
+			}
+			loaded[name] = t
+		}
+	}
+}
+
+// loadTable loads the table which has the columns of "Month", "L", "M" and "S"
+// of the consecutive months.
+func loadTable(name string) (table, error) {
+	f, err := tables.Open(name)
+	if err != nil {
+		return table{}, err
+	}
+	defer f.Close()
+	var t table
+	scanner := bufio.NewScanner(f)
+	scanner.Scan() // skip the header.
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 4 {
+			return table{}, fmt.Errorf("growth: %s: invalid line %q", name, scanner.Text())
+		}
+		month, err := strconv.Atoi(fields[0])
+		if len(t.rows) == 0 {
+			t.first = month
+		}
+		if err != nil || month != t.first+len(t.rows) {
+			return table{}, fmt.Errorf("growth: %s: invalid month %q", name, fields[0])
+		}
+		var v [3]float64
+		for i := range v {
+			if v[i], err = strconv.ParseFloat(fields[i+1], 64); err != nil {
+				return table{}, fmt.Errorf("growth: %s: %w", name, err)
+			}
+		}
+		t.rows = append(t.rows, lms{v[0], v[1], v[2]})
+	}
+	return t, scanner.Err()
+}
+
+// parameters returns the LMS parameters interpolated by the age in days.
+func parameters(ind Indicator, sex piyolog.Sex, days int) (lms, error) {
+	month := float64(days) / daysPerMonth
+	var name string
+	switch sex {
+	case piyolog.SexMale:
+		name = ind.table(month) + "_boys"
+	case piyolog.SexFemale:
+		name = ind.table(month) + "_girls"
+	default:
+		return lms{}, ErrUnknownSex
+	}
+	t, ok := loaded[name]
+	if !ok {
+		return lms{}, fmt.Errorf("growth: unknown indicator %d", ind)
+	}
+	month -= float64(t.first)
+	if days < 0 || month < 0 || month > float64(len(t.rows)-1) {
+		return lms{}, ErrOutOfRange
+	}
+	rows := t.rows
+	i := int(month)
+	if i == len(rows)-1 {
+		return rows[i], nil
+	}
+	r := month - float64(i)
+	return lms{
+		L: rows[i].L + (rows[i+1].L-rows[i].L)*r,
+		M: rows[i].M + (rows[i+1].M-rows[i].M)*r,
+		S: rows[i].S + (rows[i+1].S-rows[i].S)*r,
+	}, nil
+}
+
+// ZScore returns the z-score of the value measured at the age in days.
+// The value is in kg for WeightForAge, otherwise in cm.
+func ZScore(ind Indicator, sex piyolog.Sex, days int, value float64) (float64, error) {
+	p, err := parameters(ind, sex, days)
+	if err != nil {
+		return 0, err
+	}
+	z := p.zScore(value)
+	// the WHO restricts the LMS method to the range of ±3 SD for weight-based
+	// indicators, and extrapolates linearly beyond it.
+	if ind == WeightForAge {
+		switch {
+		case z > 3:
+			sd3 := p.value(3)
+			z = 3 + (value-sd3)/(sd3-p.value(2))
+		case z < -3:
+			sd3 := p.value(-3)
+			z = -3 + (value-sd3)/(p.value(-2)-sd3)
+		}
+	}
+	return z, nil
+}
+
+// Percentile returns the percentile of the z-score.
+func Percentile(z float64) float64 {
+	return 50 * (1 + math.Erf(z/math.Sqrt2))
+}
+
+// Measurement represents a height, weight or head circumference log evaluated
+// against the standards.
+type Measurement struct {
+	Indicator Indicator
+	Log       piyolog.Log
+	// AgeDays is the age in days at the time of the measurement.
+	AgeDays int
+	// Value is the measurement in kg for WeightForAge, otherwise in cm.
+	Value      float64
+	ZScore     float64
+	Percentile float64
+	// Err is the reason why the measurement cannot be evaluated, such as
+	// ErrOutOfRange. ZScore and Percentile are zero if it is not nil.
+	Err error
+}
+
+// Measurements returns the measurements in the data of the baby of the sex.
+// The sex of the baby of every entry is used if sex is piyolog.SexUnknown,
+// since export data does not include it. Entries without the baby information
+// are skipped since the age is unknown.
+func Measurements(data *piyolog.Data, sex piyolog.Sex) []Measurement {
+	var ms []Measurement
+	for _, e := range data.Entries {
+		if e.Baby == nil {
+			continue
+		}
+		sex := sex
+		if sex == piyolog.SexUnknown {
+			sex = e.Baby.Sex
+		}
+		for _, l := range e.Logs {
+			m := Measurement{
+				Log:     l,
+				AgeDays: e.Baby.AgeDays(l.CreatedAt()),
+			}
+			switch v := l.(type) {
+			case piyolog.WeightLog:
+				m.Indicator = WeightForAge
+				m.Value, m.Err = kilograms(v.Weight, v.Unit)
+			case piyolog.HeightLog:
+				m.Indicator = LengthForAge
+				m.Value, m.Err = centimeters(v.Height, v.Unit)
+			case piyolog.HeadCircumferenceLog:
+				m.Indicator = HeadCircumferenceForAge
+				m.Value, m.Err = centimeters(v.Circumference, v.Unit)
+			default:
+				continue
+			}
+			if m.Err == nil {
+				m.ZScore, m.Err = ZScore(m.Indicator, sex, m.AgeDays, m.Value)
+			}
+			if m.Err == nil {
+				m.Percentile = Percentile(m.ZScore)
+			} else {
+				m.ZScore = 0
+			}
+			ms = append(ms, m)
+		}
+	}
+	return ms
+}
+
+func kilograms(v float64, unit string) (float64, error) {
+	switch unit {
+	case "kg":
+		return v, nil
+	case "g":
+		return v / 1000, nil
+	case "lb", "lbs":
+		return v * 0.45359237, nil
+	}
+	return 0, fmt.Errorf("%w: %q", ErrUnknownUnit, unit)
+}
+
+func centimeters(v float64, unit string) (float64, error) {
+	switch unit {
+	case "cm":
+		return v, nil
+	case "mm":
+		return v / 10, nil
+	case "in":
+		return v * 2.54, nil
+	}
+	return 0, fmt.Errorf("%w: %q", ErrUnknownUnit, unit)
+}
@@ -0,0 +1,194 @@
+package growth
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/kaneshin/piyolog"
+)
+
+func Test_ZScore(t *testing.T) {
+	tests := []struct {
+		name  string
+		ind   Indicator
+		sex   piyolog.Sex
+		days  int
+		value float64
+		z     float64
+		err   error
+	}{
+		{
+			name:  "median weight at birth",
+			ind:   WeightForAge,
+			sex:   piyolog.SexMale,
+			days:  0,
+			value: 3.3464,
+			z:     0,
+		},
+		{
+			name:  "median length at 12 months",
+			ind:   LengthForAge,
+			sex:   piyolog.SexFemale,
+			days:  365,
+			value: 74.0,
+			z:     0,
+		},
+		{
+			name:  "heavy weight",
+			ind:   WeightForAge,
+			sex:   piyolog.SexMale,
+			days:  0,
+			value: 5.0,
+			z:     2.95,
+		},
+		{
+			name:  "small head",
+			ind:   HeadCircumferenceForAge,
+			sex:   piyolog.SexMale,
+			days:  0,
+			value: 32.1,
+			z:     -1.86,
+		},
+		{
+			name:  "median height at 24 months",
+			ind:   LengthForAge,
+			sex:   piyolog.SexMale,
+			days:  731,
+			value: 87.13,
+			z:     0,
+		},
+		{
+			name:  "median height at 36 months",
+			ind:   LengthForAge,
+			sex:   piyolog.SexFemale,
+			days:  1096,
+			value: 95.06,
+			z:     0,
+		},
+		{
+			name:  "median weight at 60 months",
+			ind:   WeightForAge,
+			sex:   piyolog.SexMale,
+			days:  1826,
+			value: 18.34,
+			z:     0,
+		},
+		{
+			name: "unknown sex",
+			ind:  WeightForAge,
+			days: 0,
+			err:  ErrUnknownSex,
+		},
+		{
+			name: "out of range",
+			ind:  WeightForAge,
+			sex:  piyolog.SexMale,
+			days: 365 * 6,
+			err:  ErrOutOfRange,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z, err := ZScore(tt.ind, tt.sex, tt.days, tt.value)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ZScore error: %v, want %v", err, tt.err)
+			}
+			if math.Abs(z-tt.z) > 0.05 {
+				t.Errorf("ZScore = %f, want %f", z, tt.z)
+			}
+		})
+	}
+}
+
+func Test_Percentile(t *testing.T) {
+	tests := []struct {
+		z, p float64
+	}{
+		{0, 50},
+		{-2, 2.28},
+		{1, 84.13},
+	}
+	for _, tt := range tests {
+		if p := Percentile(tt.z); math.Abs(p-tt.p) > 0.01 {
+			t.Errorf("Percentile(%f) = %f, want %f", tt.z, p, tt.p)
+		}
+	}
+}
+
+func Test_Measurements(t *testing.T) {
+	data, err := piyolog.Parse(`【ぴよログ】2024/1/15(月)
+ぴよ (0歳3か月0日)
+
+09:00   身長 61.4cm   
+09:02   体重 6400g   
+09:04   ミルク 120ml   
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ms := Measurements(data, piyolog.SexMale)
+	if len(ms) != 2 {
+		t.Fatalf("Measurements returns %d measurements, want 2", len(ms))
+	}
+	want := []struct {
+		ind   Indicator
+		days  int
+		value float64
+	}{
+		{LengthForAge, 92, 61.4},
+		{WeightForAge, 92, 6.4},
+	}
+	for i, w := range want {
+		m := ms[i]
+		if m.Indicator != w.ind || m.AgeDays != w.days || math.Abs(m.Value-w.value) > 1e-9 {
+			t.Errorf("Measurements[%d] = %v %d %f, want %v %d %f",
+				i, m.Indicator, m.AgeDays, m.Value, w.ind, w.days, w.value)
+		}
+		if m.Err != nil {
+			t.Errorf("Measurements[%d] error: %v", i, m.Err)
+		}
+		if math.Abs(m.ZScore) > 0.2 || math.Abs(m.Percentile-50) > 10 {
+			t.Errorf("Measurements[%d] = z %f, p %f, want around the median", i, m.ZScore, m.Percentile)
+		}
+	}
+
+	for _, m := range Measurements(data, piyolog.SexUnknown) {
+		if !errors.Is(m.Err, ErrUnknownSex) {
+			t.Errorf("Measurements error: %v, want %v", m.Err, ErrUnknownSex)
+		}
+	}
+	data.Entries[0].Baby.Sex = piyolog.SexFemale
+	if ms := Measurements(data, piyolog.SexUnknown); len(ms) != 2 || ms[0].Err != nil {
+		t.Errorf("the sex of the baby must be used: %+v", ms)
+	}
+}
+
+func Test_MeasurementsOutOfRange(t *testing.T) {
+	data, err := piyolog.Parse(`【ぴよログ】2024年1月
+----------
+2024/1/15(月)
+ぴよ (0歳3か月0日)
+
+09:00   体重 6400g   
+----------
+2024/1/16(火)
+ぴよ (5歳1か月0日)
+
+09:00   体重 18.5kg   
+----------
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ms := Measurements(data, piyolog.SexMale)
+	if len(ms) != 2 {
+		t.Fatalf("Measurements returns %d measurements, want 2", len(ms))
+	}
+	if ms[0].Err != nil || ms[0].Percentile == 0 {
+		t.Errorf("Measurements[0] = %+v", ms[0])
+	}
+	if !errors.Is(ms[1].Err, ErrOutOfRange) || ms[1].ZScore != 0 {
+		t.Errorf("Measurements[1] = %+v, want %v", ms[1], ErrOutOfRange)
+	}
+}
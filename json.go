@@ -0,0 +1,652 @@
+package piyolog
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+var logUnmarshalers = map[Kind]func([]byte) (Log, error){
+	KindOther:             unmarshalLog[LogItem],
+	KindNursing:           unmarshalLog[NursingLog],
+	KindFormula:           unmarshalLog[FormulaLog],
+	KindSolid:             unmarshalLog[SolidLog],
+	KindSleep:             unmarshalLog[SleepLog],
+	KindWakeUp:            unmarshalLog[WakeUpLog],
+	KindPee:               unmarshalLog[PeeLog],
+	KindPoop:              unmarshalLog[PoopLog],
+	KindBaths:             unmarshalLog[BathsLog],
+	KindBodyTemperature:   unmarshalLog[BodyTemperatureLog],
+	KindPumpedMilk:        unmarshalLog[PumpedMilkLog],
+	KindDrink:             unmarshalLog[DrinkLog],
+	KindSnack:             unmarshalLog[SnackLog],
+	KindHeight:            unmarshalLog[HeightLog],
+	KindWeight:            unmarshalLog[WeightLog],
+	KindHeadCircumference: unmarshalLog[HeadCircumferenceLog],
+	KindMedicine:          unmarshalLog[MedicineLog],
+	KindHospital:          unmarshalLog[HospitalLog],
+	KindVaccination:       unmarshalLog[VaccinationLog],
+	KindVomit:             unmarshalLog[VomitLog],
+	KindCough:             unmarshalLog[CoughLog],
+	KindRash:              unmarshalLog[RashLog],
+	KindInjury:            unmarshalLog[InjuryLog],
+	KindWalk:              unmarshalLog[WalkLog],
+	KindPumping:           unmarshalLog[PumpingLog],
+	KindMemo:              unmarshalLog[MemoLog],
+}
+
+func unmarshalLog[T Log](b []byte) (Log, error) {
+	var l T
+	if err := json.Unmarshal(b, &l); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// UnmarshalLog returns the log value decoded from the JSON encoding of a log.
+// The concrete type of the log is determined by its "type" field.
+func UnmarshalLog(b []byte) (Log, error) {
+	var v struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+	kind, ok := parseKind(v.Type)
+	unmarshal := logUnmarshalers[kind]
+	if !ok || unmarshal == nil {
+		return nil, fmt.Errorf("piyolog: unknown log type %q", v.Type)
+	}
+	return unmarshal(b)
+}
+
+func (e *Entry) UnmarshalJSON(b []byte) error {
+	type entry Entry
+	v := struct {
+		*entry
+		Logs []json.RawMessage `json:"logs"`
+	}{
+		entry: (*entry)(e),
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	e.Logs = nil
+	for _, raw := range v.Logs {
+		l, err := UnmarshalLog(raw)
+		if err != nil {
+			return err
+		}
+		e.Logs = append(e.Logs, l)
+	}
+	e.section = sectionEnd
+	return nil
+}
+
+func (c *Change) UnmarshalJSON(b []byte) error {
+	type change Change
+	v := struct {
+		*change
+		OldLog json.RawMessage `json:"old_log"`
+		NewLog json.RawMessage `json:"new_log"`
+	}{
+		change: (*change)(c),
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	c.OldLog, c.NewLog = nil, nil
+	var err error
+	if v.OldLog != nil {
+		if c.OldLog, err = UnmarshalLog(v.OldLog); err != nil {
+			return err
+		}
+	}
+	if v.NewLog != nil {
+		if c.NewLog, err = UnmarshalLog(v.NewLog); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type logItemJSON struct {
+	Type      string    `json:"type"`
+	Name      string    `json:"name"`
+	Content   string    `json:"content"`
+	Notes     string    `json:"notes"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func newLogItemJSON(kind Kind, i LogItem) logItemJSON {
+	return logItemJSON{
+		Type:      kind.String(),
+		Name:      i.typ,
+		Content:   i.content,
+		Notes:     i.notes,
+		CreatedAt: i.createdAt,
+	}
+}
+
+func (v logItemJSON) logItem() LogItem {
+	return NewLogItem(v.Name, v.Content, v.Notes, v.CreatedAt)
+}
+
+func (i LogItem) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newLogItemJSON(KindOther, i))
+}
+
+func (i *LogItem) UnmarshalJSON(b []byte) error {
+	var v logItemJSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*i = v.logItem()
+	return nil
+}
+
+func (k Kind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+func (k *Kind) UnmarshalText(b []byte) error {
+	kind, ok := parseKind(string(b))
+	if !ok {
+		return fmt.Errorf("piyolog: unknown kind %q", b)
+	}
+	*k = kind
+	return nil
+}
+
+func (u VolumeUnit) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+func (u *VolumeUnit) UnmarshalText(b []byte) error {
+	for v := VolumeUnitUnknown; v <= FluidOunce; v++ {
+		if v.String() == string(b) {
+			*u = v
+			return nil
+		}
+	}
+	return fmt.Errorf("piyolog: unknown volume unit %q", b)
+}
+
+func (u TemperatureUnit) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+func (u *TemperatureUnit) UnmarshalText(b []byte) error {
+	for v := TemperatureUnitUnknown; v <= Fahrenheit; v++ {
+		if v.String() == string(b) {
+			*u = v
+			return nil
+		}
+	}
+	return fmt.Errorf("piyolog: unknown temperature unit %q", b)
+}
+
+func (s Side) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+func (s *Side) UnmarshalText(b []byte) error {
+	switch string(b) {
+	case "":
+		*s = SideNone
+	case "left":
+		*s = SideLeft
+	case "right":
+		*s = SideRight
+	default:
+		return fmt.Errorf("piyolog: unknown side %q", b)
+	}
+	return nil
+}
+
+func (s Sex) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+func (s *Sex) UnmarshalText(b []byte) error {
+	switch string(b) {
+	case "":
+		*s = SexUnknown
+	case "male":
+		*s = SexMale
+	case "female":
+		*s = SexFemale
+	default:
+		return fmt.Errorf("piyolog: unknown sex %q", b)
+	}
+	return nil
+}
+
+func (a Amount) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+func (a *Amount) UnmarshalText(b []byte) error {
+	for v := AmountUnknown; v <= AmountLot; v++ {
+		if v.String() == string(b) {
+			*a = v
+			return nil
+		}
+	}
+	return fmt.Errorf("piyolog: unknown amount %q", b)
+}
+
+func (c Consistency) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+func (c *Consistency) UnmarshalText(b []byte) error {
+	for v := ConsistencyUnknown; v <= ConsistencyDiarrhea; v++ {
+		if v.String() == string(b) {
+			*c = v
+			return nil
+		}
+	}
+	return fmt.Errorf("piyolog: unknown consistency %q", b)
+}
+
+func (c Color) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+func (c *Color) UnmarshalText(b []byte) error {
+	for v := ColorUnknown; v <= ColorWhite; v++ {
+		if v.String() == string(b) {
+			*c = v
+			return nil
+		}
+	}
+	return fmt.Errorf("piyolog: unknown color %q", b)
+}
+
+func (t ChangeType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t *ChangeType) UnmarshalText(b []byte) error {
+	for v := ChangeAdded; v <= ChangeModified; v++ {
+		if v.String() == string(b) {
+			*t = v
+			return nil
+		}
+	}
+	return fmt.Errorf("piyolog: unknown change type %q", b)
+}
+
+type nursingLogJSON struct {
+	logItemJSON
+	Left     time.Duration `json:"left"`
+	Right    time.Duration `json:"right"`
+	LastSide Side          `json:"last_side"`
+	Amount   float64       `json:"amount"`
+	Unit     VolumeUnit    `json:"unit"`
+}
+
+func (l NursingLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(nursingLogJSON{
+		logItemJSON: newLogItemJSON(KindNursing, l.LogItem),
+		Left:        l.Left,
+		Right:       l.Right,
+		LastSide:    l.LastSide,
+		Amount:      l.Volume.Value,
+		Unit:        l.Volume.Unit,
+	})
+}
+
+func (l *NursingLog) UnmarshalJSON(b []byte) error {
+	var v nursingLogJSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*l = NursingLog{
+		LogItem:  v.logItem(),
+		Left:     v.Left,
+		Right:    v.Right,
+		LastSide: v.LastSide,
+		Volume:   Volume{Value: v.Amount, Unit: v.Unit},
+	}
+	return nil
+}
+
+type amountLogJSON struct {
+	logItemJSON
+	Amount float64    `json:"amount"`
+	Unit   VolumeUnit `json:"unit"`
+}
+
+func (l FormulaLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(amountLogJSON{
+		logItemJSON: newLogItemJSON(KindFormula, l.LogItem),
+		Amount:      l.Volume.Value,
+		Unit:        l.Volume.Unit,
+	})
+}
+
+func (l *FormulaLog) UnmarshalJSON(b []byte) error {
+	var v amountLogJSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*l = FormulaLog{
+		LogItem: v.logItem(),
+		Volume:  Volume{Value: v.Amount, Unit: v.Unit},
+	}
+	return nil
+}
+
+func (l SolidLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newLogItemJSON(KindSolid, l.LogItem))
+}
+
+func (l SleepLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newLogItemJSON(KindSleep, l.LogItem))
+}
+
+type durationLogJSON struct {
+	logItemJSON
+	Duration time.Duration `json:"duration"`
+}
+
+func (l WakeUpLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(durationLogJSON{
+		logItemJSON: newLogItemJSON(KindWakeUp, l.LogItem),
+		Duration:    l.Duration,
+	})
+}
+
+func (l *WakeUpLog) UnmarshalJSON(b []byte) error {
+	var v durationLogJSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*l = WakeUpLog{
+		LogItem:  v.logItem(),
+		Duration: v.Duration,
+	}
+	return nil
+}
+
+type peeLogJSON struct {
+	logItemJSON
+	Amount Amount `json:"amount"`
+}
+
+func (l PeeLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(peeLogJSON{
+		logItemJSON: newLogItemJSON(KindPee, l.LogItem),
+		Amount:      l.Amount,
+	})
+}
+
+func (l *PeeLog) UnmarshalJSON(b []byte) error {
+	var v peeLogJSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*l = PeeLog{
+		LogItem: v.logItem(),
+		Amount:  v.Amount,
+	}
+	return nil
+}
+
+type poopLogJSON struct {
+	logItemJSON
+	Amount      Amount      `json:"amount"`
+	Consistency Consistency `json:"consistency"`
+	Color       Color       `json:"color"`
+}
+
+func (l PoopLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(poopLogJSON{
+		logItemJSON: newLogItemJSON(KindPoop, l.LogItem),
+		Amount:      l.Amount,
+		Consistency: l.Consistency,
+		Color:       l.Color,
+	})
+}
+
+func (l *PoopLog) UnmarshalJSON(b []byte) error {
+	var v poopLogJSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*l = PoopLog{
+		LogItem:     v.logItem(),
+		Amount:      v.Amount,
+		Consistency: v.Consistency,
+		Color:       v.Color,
+	}
+	return nil
+}
+
+func (l BathsLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newLogItemJSON(KindBaths, l.LogItem))
+}
+
+type bodyTemperatureLogJSON struct {
+	logItemJSON
+	Temperature float64         `json:"temperature"`
+	Unit        TemperatureUnit `json:"unit"`
+}
+
+func (l BodyTemperatureLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(bodyTemperatureLogJSON{
+		logItemJSON: newLogItemJSON(KindBodyTemperature, l.LogItem),
+		Temperature: l.Temperature.Value,
+		Unit:        l.Temperature.Unit,
+	})
+}
+
+func (l *BodyTemperatureLog) UnmarshalJSON(b []byte) error {
+	var v bodyTemperatureLogJSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*l = BodyTemperatureLog{
+		LogItem:     v.logItem(),
+		Temperature: Temperature{Value: v.Temperature, Unit: v.Unit},
+	}
+	return nil
+}
+
+func (l PumpedMilkLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(amountLogJSON{
+		logItemJSON: newLogItemJSON(KindPumpedMilk, l.LogItem),
+		Amount:      l.Volume.Value,
+		Unit:        l.Volume.Unit,
+	})
+}
+
+func (l *PumpedMilkLog) UnmarshalJSON(b []byte) error {
+	var v amountLogJSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*l = PumpedMilkLog{
+		LogItem: v.logItem(),
+		Volume:  Volume{Value: v.Amount, Unit: v.Unit},
+	}
+	return nil
+}
+
+func (l DrinkLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(amountLogJSON{
+		logItemJSON: newLogItemJSON(KindDrink, l.LogItem),
+		Amount:      l.Volume.Value,
+		Unit:        l.Volume.Unit,
+	})
+}
+
+func (l *DrinkLog) UnmarshalJSON(b []byte) error {
+	var v amountLogJSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*l = DrinkLog{
+		LogItem: v.logItem(),
+		Volume:  Volume{Value: v.Amount, Unit: v.Unit},
+	}
+	return nil
+}
+
+func (l SnackLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newLogItemJSON(KindSnack, l.LogItem))
+}
+
+type measurementLogJSON struct {
+	logItemJSON
+	Value float64 `json:"value"`
+	Unit  string  `json:"unit"`
+}
+
+func (l HeightLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(measurementLogJSON{
+		logItemJSON: newLogItemJSON(KindHeight, l.LogItem),
+		Value:       l.Height,
+		Unit:        l.Unit,
+	})
+}
+
+func (l *HeightLog) UnmarshalJSON(b []byte) error {
+	var v measurementLogJSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*l = HeightLog{
+		LogItem: v.logItem(),
+		Height:  v.Value,
+		Unit:    v.Unit,
+	}
+	return nil
+}
+
+func (l WeightLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(measurementLogJSON{
+		logItemJSON: newLogItemJSON(KindWeight, l.LogItem),
+		Value:       l.Weight,
+		Unit:        l.Unit,
+	})
+}
+
+func (l *WeightLog) UnmarshalJSON(b []byte) error {
+	var v measurementLogJSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*l = WeightLog{
+		LogItem: v.logItem(),
+		Weight:  v.Value,
+		Unit:    v.Unit,
+	}
+	return nil
+}
+
+func (l HeadCircumferenceLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(measurementLogJSON{
+		logItemJSON: newLogItemJSON(KindHeadCircumference, l.LogItem),
+		Value:       l.Circumference,
+		Unit:        l.Unit,
+	})
+}
+
+func (l *HeadCircumferenceLog) UnmarshalJSON(b []byte) error {
+	var v measurementLogJSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*l = HeadCircumferenceLog{
+		LogItem:       v.logItem(),
+		Circumference: v.Value,
+		Unit:          v.Unit,
+	}
+	return nil
+}
+
+func (l MedicineLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newLogItemJSON(KindMedicine, l.LogItem))
+}
+
+func (l HospitalLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newLogItemJSON(KindHospital, l.LogItem))
+}
+
+func (l VaccinationLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newLogItemJSON(KindVaccination, l.LogItem))
+}
+
+func (l VomitLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newLogItemJSON(KindVomit, l.LogItem))
+}
+
+func (l CoughLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newLogItemJSON(KindCough, l.LogItem))
+}
+
+func (l RashLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newLogItemJSON(KindRash, l.LogItem))
+}
+
+func (l InjuryLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newLogItemJSON(KindInjury, l.LogItem))
+}
+
+func (l WalkLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(durationLogJSON{
+		logItemJSON: newLogItemJSON(KindWalk, l.LogItem),
+		Duration:    l.Duration,
+	})
+}
+
+func (l *WalkLog) UnmarshalJSON(b []byte) error {
+	var v durationLogJSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*l = WalkLog{
+		LogItem:  v.logItem(),
+		Duration: v.Duration,
+	}
+	return nil
+}
+
+type pumpingLogJSON struct {
+	logItemJSON
+	Left   time.Duration `json:"left"`
+	Right  time.Duration `json:"right"`
+	Amount float64       `json:"amount"`
+	Unit   VolumeUnit    `json:"unit"`
+}
+
+func (l PumpingLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(pumpingLogJSON{
+		logItemJSON: newLogItemJSON(KindPumping, l.LogItem),
+		Left:        l.Left,
+		Right:       l.Right,
+		Amount:      l.Volume.Value,
+		Unit:        l.Volume.Unit,
+	})
+}
+
+func (l *PumpingLog) UnmarshalJSON(b []byte) error {
+	var v pumpingLogJSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*l = PumpingLog{
+		LogItem: v.logItem(),
+		Left:    v.Left,
+		Right:   v.Right,
+		Volume:  Volume{Value: v.Amount, Unit: v.Unit},
+	}
+	return nil
+}
+
+func (l MemoLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newLogItemJSON(KindMemo, l.LogItem))
+}
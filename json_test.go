@@ -0,0 +1,112 @@
+package piyolog
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"golang.org/x/text/language"
+)
+
+// equateLogItem compares LogItem values regardless of the locations of their times.
+var equateLogItem = cmp.Comparer(func(x, y LogItem) bool {
+	return x.typ == y.typ && x.content == y.content && x.notes == y.notes && x.createdAt.Equal(y.createdAt)
+})
+
+func Test_JSON(t *testing.T) {
+	for _, tt := range parseTests {
+		if len(tt.out.Entries) == 0 {
+			continue
+		}
+		t.Run(tt.in, func(t *testing.T) {
+			data, err := Parse(tt.in)
+			if err != nil {
+				t.Fatalf("unexpected error returned: %v", err)
+			}
+			b, err := json.Marshal(data)
+			if err != nil {
+				t.Fatalf("unexpected error returned: %v", err)
+			}
+			var got Data
+			if err := json.Unmarshal(b, &got); err != nil {
+				t.Fatalf("unexpected error returned: %v", err)
+			}
+			if diff := cmp.Diff(*data, got, equateLogItem, cmpopts.EquateComparable(language.Tag{}), cmpopts.IgnoreUnexported(Entry{})); diff != "" {
+				t.Errorf("%s", diff)
+			}
+		})
+	}
+}
+
+func Test_UnmarshalLog(t *testing.T) {
+	date := time.Date(2023, time.December, 31, 0, 0, 0, 0, piyoLoc)
+	tests := []struct {
+		in   string
+		json string
+	}{
+		{
+			in:   `23:00   母乳 左 7分 / 右 5分 ← (50ml)   たくさん飲んだ`,
+			json: `{"type":"nursing","name":"母乳","content":"左 7分 / 右 5分 ← (50ml)","notes":"たくさん飲んだ","created_at":"2023-12-31T23:00:00+09:00","left":420000000000,"right":300000000000,"last_side":"right","amount":50,"unit":"ml"}`,
+		},
+		{
+			in:   `08:45 AM   ミルク 140ml   `,
+			json: `{"type":"formula","name":"ミルク","content":"140ml","notes":"","created_at":"2023-12-31T08:45:00+09:00","amount":140,"unit":"ml"}`,
+		},
+		{
+			in:   `08:00 PM   寝る   `,
+			json: `{"type":"sleep","name":"寝る","content":"","notes":"","created_at":"2023-12-31T20:00:00+09:00"}`,
+		},
+		{
+			in:   `02:55   起きる (3時間35分)   `,
+			json: `{"type":"wake-up","name":"起きる","content":"(3時間35分)","notes":"","created_at":"2023-12-31T02:55:00+09:00","duration":12900000000000}`,
+		},
+		{
+			in:   `14:30   体温 36.5°C   `,
+			json: `{"type":"body-temperature","name":"体温","content":"36.5°C","notes":"","created_at":"2023-12-31T14:30:00+09:00","temperature":36.5,"unit":"°C"}`,
+		},
+		{
+			in:   `18:00   搾乳 左 10分 / 右 5分 (80ml)   `,
+			json: `{"type":"pumping","name":"搾乳","content":"左 10分 / 右 5分 (80ml)","notes":"","created_at":"2023-12-31T18:00:00+09:00","left":600000000000,"right":300000000000,"amount":80,"unit":"ml"}`,
+		},
+		{
+			in:   `09:05 AM   Head circ. 16.2in   `,
+			json: `{"type":"head-circumference","name":"Head circ.","content":"16.2in","notes":"","created_at":"2023-12-31T09:05:00+09:00","value":16.2,"unit":"in"}`,
+		},
+		{
+			in:   `17:00   散歩 (30分)   `,
+			json: `{"type":"walk","name":"散歩","content":"(30分)","notes":"","created_at":"2023-12-31T17:00:00+09:00","duration":1800000000000}`,
+		},
+		{
+			in:   `16:00   嘔吐   `,
+			json: `{"type":"vomit","name":"嘔吐","content":"","notes":"","created_at":"2023-12-31T16:00:00+09:00"}`,
+		},
+		{
+			in:   `14:30   おむつ   `,
+			json: `{"type":"other","name":"おむつ","content":"","notes":"","created_at":"2023-12-31T14:30:00+09:00"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			lg, err := NewLog(tt.in, date)
+			if err != nil {
+				t.Fatalf("unexpected error returned: %v", err)
+			}
+			b, err := json.Marshal(lg)
+			if err != nil {
+				t.Fatalf("unexpected error returned: %v", err)
+			}
+			if diff := cmp.Diff(tt.json, string(b)); diff != "" {
+				t.Errorf("%s", diff)
+			}
+			got, err := UnmarshalLog(b)
+			if err != nil {
+				t.Fatalf("unexpected error returned: %v", err)
+			}
+			if diff := cmp.Diff(lg, got, equateLogItem); diff != "" {
+				t.Errorf("%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package piyolog
+
+import (
+	"sort"
+
+	"golang.org/x/text/language"
+)
+
+// Kind represents the kind of a log regardless of the language of its type.
+type Kind int
+
+const (
+	KindOther Kind = iota
+	KindNursing
+	KindFormula
+	KindSolid
+	KindSleep
+	KindWakeUp
+	KindPee
+	KindPoop
+	KindBaths
+	KindBodyTemperature
+	KindPumpedMilk
+	KindDrink
+	KindSnack
+	KindHeight
+	KindWeight
+	KindHeadCircumference
+	KindMedicine
+	KindHospital
+	KindVaccination
+	KindVomit
+	KindCough
+	KindRash
+	KindInjury
+	KindWalk
+	KindPumping
+	KindMemo
+)
+
+// kindNames are the names of the kinds, which are also used as the "type"
+// field of a log encoded in JSON.
+var kindNames = [...]string{
+	KindOther:             "other",
+	KindNursing:           "nursing",
+	KindFormula:           "formula",
+	KindSolid:             "solid",
+	KindSleep:             "sleep",
+	KindWakeUp:            "wake-up",
+	KindPee:               "pee",
+	KindPoop:              "poop",
+	KindBaths:             "baths",
+	KindBodyTemperature:   "body-temperature",
+	KindPumpedMilk:        "pumped-milk",
+	KindDrink:             "drink",
+	KindSnack:             "snack",
+	KindHeight:            "height",
+	KindWeight:            "weight",
+	KindHeadCircumference: "head-circumference",
+	KindMedicine:          "medicine",
+	KindHospital:          "hospital",
+	KindVaccination:       "vaccination",
+	KindVomit:             "vomit",
+	KindCough:             "cough",
+	KindRash:              "rash",
+	KindInjury:            "injury",
+	KindWalk:              "walk",
+	KindPumping:           "pumping",
+	KindMemo:              "memo",
+}
+
+func (k Kind) String() string {
+	if k < 0 || int(k) >= len(kindNames) {
+		return kindNames[KindOther]
+	}
+	return kindNames[k]
+}
+
+// Localized returns the type name of the kind written in export data in the
+// language of the tag. It returns the English one if the language has no name
+// for the kind, and an empty string for KindOther.
+func (k Kind) Localized(tag language.Tag) string {
+	if k == KindOther {
+		return ""
+	}
+	for _, t := range []language.Tag{tag, language.English} {
+		l := lookupLocale(t)
+		if l == nil {
+			continue
+		}
+		var names []string
+		for name, kind := range l.Types {
+			if kind == k {
+				names = append(names, name)
+			}
+		}
+		if len(names) > 0 {
+			// sort the names not to depend on the order of the map.
+			sort.Strings(names)
+			return names[0]
+		}
+	}
+	return k.String()
+}
+
+func parseKind(s string) (Kind, bool) {
+	for k, name := range kindNames {
+		if name == s {
+			return Kind(k), true
+		}
+	}
+	return KindOther, false
+}
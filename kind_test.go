@@ -0,0 +1,56 @@
+package piyolog
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+func Test_Kind(t *testing.T) {
+	date := time.Date(2023, time.March, 8, 0, 0, 0, 0, piyoLoc)
+	tests := []struct {
+		in   string
+		kind Kind
+	}{
+		{in: `03:00   ミルク 90ml   `, kind: KindFormula},
+		{in: `03:00 AM   Formula 90ml   `, kind: KindFormula},
+		{in: `03:00   Body Temp. 36.8°C   `, kind: KindBodyTemperature},
+		{in: `03:00   起きる (3時間35分)   `, kind: KindWakeUp},
+		{in: `03:00   ミルク たくさん   `, kind: KindFormula},
+		{in: `03:00   だっこ   `, kind: KindOther},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			l, _ := NewLog(tt.in, date)
+			if l.Kind() != tt.kind {
+				t.Errorf("Kind() = %v, want %v", l.Kind(), tt.kind)
+			}
+		})
+	}
+}
+
+func Test_KindLocalized(t *testing.T) {
+	tests := []struct {
+		kind Kind
+		tag  language.Tag
+		want string
+	}{
+		{kind: KindFormula, tag: language.Japanese, want: "ミルク"},
+		{kind: KindFormula, tag: language.English, want: "Formula"},
+		{kind: KindHeadCircumference, tag: language.English, want: "Head circ."},
+		{kind: KindSleep, tag: language.French, want: "Sleep"},
+		{kind: KindOther, tag: language.Japanese, want: ""},
+	}
+	for _, tt := range tests {
+		if got := tt.kind.Localized(tt.tag); got != tt.want {
+			t.Errorf("%v.Localized(%v) = %q, want %q", tt.kind, tt.tag, got, tt.want)
+		}
+	}
+
+	for k := KindOther; k <= KindMemo; k++ {
+		if got, ok := parseKind(k.String()); !ok || got != k {
+			t.Errorf("parseKind(%q) = %v, want %v", k.String(), got, k)
+		}
+	}
+}
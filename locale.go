@@ -0,0 +1,314 @@
+package piyolog
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// Locale represents the words and layouts of export data in a language.
+//
+// The type names and the summary labels of every registered locale are
+// recognised regardless of the language of the data, since a log does not
+// know the language it is written in.
+type Locale struct {
+	Tag language.Tag
+	// Header is the marker at the head of export data, such as "【ぴよログ】".
+	Header string
+	// DateLayout is the layout of the date of an entry, such as "2006/1/2".
+	DateLayout string
+	// DateFormat is the format of the date of an entry with the date and the
+	// weekday in this order, such as "%s(%s)" and "%[2]s, %[1]s".
+	DateFormat string
+	// Weekdays are the names of the weekdays indexed by time.Weekday.
+	Weekdays [7]string
+	// MonthLayout is the layout of the month at the head of monthly data, such as "2006年1月".
+	MonthLayout string
+	// Age is the format of the age of a baby with the years, months and days
+	// in this order, such as "%d歳%dか月%d日".
+	Age string
+	// Types maps the type names of logs to their kinds.
+	Types map[string]Kind
+	// Total is the suffix of the labels of the results section, such as "合計".
+	// It is compared case-insensitively.
+	Total string
+	// Summary maps the labels of the results section without Total to the
+	// totals, which are "nursing", "formula", "pumped milk", "solid", "sleep",
+	// "pee" and "poop".
+	Summary map[string]string
+	// LogHeader and SummaryHeader are the headers of the CSV of the logs and
+	// the daily totals written by package export/csv. The English headers are
+	// written instead if they do not have the names of all columns.
+	LogHeader, SummaryHeader []string
+}
+
+type locale struct {
+	Locale
+	reBaby *regexp.Regexp
+}
+
+var locales []*locale
+
+// RegisterLocale registers the locale to parse and write export data in its language.
+// Only the locales of Japanese and English are registered by default, so the
+// locale of another language must be registered before parsing its export data.
+// It replaces the locale registered with the same tag.
+// It must not be called concurrently with parsing and writing.
+func RegisterLocale(l Locale) error {
+	if l.Header == "" {
+		return fmt.Errorf("piyolog: %s locale: no header", l.Tag)
+	}
+	if n := strings.Count(l.DateFormat, "%"); n != 2 {
+		return fmt.Errorf("piyolog: %s locale: invalid date format %q", l.Tag, l.DateFormat)
+	}
+	if n := strings.Count(l.Age, "%d"); n != 3 {
+		return fmt.Errorf("piyolog: %s locale: invalid age %q", l.Tag, l.Age)
+	}
+	age := strings.ReplaceAll(regexp.QuoteMeta(l.Age), "%d", "([0-9]+)")
+	loc := &locale{
+		Locale: l,
+		reBaby: regexp.MustCompile(`^(.*) \(` + age + `\)$`),
+	}
+	defer updateMultiWordTypes()
+	for i, v := range locales {
+		if v.Tag == l.Tag {
+			locales[i] = loc
+			return nil
+		}
+	}
+	locales = append(locales, loc)
+	return nil
+}
+
+// LookupLocale returns the locale registered with the tag.
+func LookupLocale(tag language.Tag) (Locale, bool) {
+	if l := lookupLocale(tag); l != nil {
+		return l.Locale, true
+	}
+	return Locale{}, false
+}
+
+func lookupLocale(tag language.Tag) *locale {
+	for _, l := range locales {
+		if l.Tag == tag {
+			return l
+		}
+	}
+	return nil
+}
+
+// detectLocale returns the locale whose header is in str.
+// If some locales have the same header, the one whose weekday is in str is preferred.
+func detectLocale(str string) *locale {
+	var found *locale
+	for _, l := range locales {
+		if !strings.Contains(str, l.Header) {
+			continue
+		}
+		if found == nil {
+			found = l
+		}
+		for _, w := range l.Weekdays {
+			if w != "" && strings.Contains(str, w) {
+				return l
+			}
+		}
+	}
+	return found
+}
+
+// shared reports whether another locale has the same header, so that the
+// head without the weekday cannot tell them apart.
+func (l *locale) shared() bool {
+	for _, v := range locales {
+		if v != l && v.Header == l.Header {
+			return true
+		}
+	}
+	return false
+}
+
+// formatDate returns the date of an entry written in the locale.
+func (l *locale) formatDate(date time.Time) string {
+	return fmt.Sprintf(l.DateFormat, date.Format(l.DateLayout), l.Weekdays[date.Weekday()])
+}
+
+// parseDate returns the date of an entry written in the locale.
+// The weekday is ignored.
+func (l *locale) parseDate(str string, loc *time.Location) (time.Time, error) {
+	f := fmt.Sprintf(l.DateFormat, "\x00", "\x01")
+	i, j := strings.Index(f, "\x00"), strings.Index(f, "\x01")
+	if i < j {
+		// the date is followed by the weekday, such as "2023/3/8(水)".
+		str = strings.TrimPrefix(str, f[:i])
+		if sep := f[i+1 : j]; sep != "" {
+			str, _, _ = strings.Cut(str, sep)
+		}
+	} else {
+		// the weekday is followed by the date, such as "Wed, Mar 8, 2023".
+		if _, date, ok := strings.Cut(str, f[j+1:i]); ok {
+			str = date
+		}
+		str = strings.TrimSuffix(str, f[i+1:])
+	}
+	return time.ParseInLocation(l.DateLayout, str, loc)
+}
+
+// lookupKind returns the kind of the type name of a log.
+func lookupKind(name string) Kind {
+	for _, l := range locales {
+		if kind, ok := l.Types[name]; ok {
+			return kind
+		}
+	}
+	return KindOther
+}
+
+// multiWordTypes are the words of the type names which contain spaces in
+// descending order of the number of the words, updated by RegisterLocale.
+var multiWordTypes [][]string
+
+func updateMultiWordTypes() {
+	var types [][]string
+	for _, l := range locales {
+		for name := range l.Types {
+			if words := strings.Fields(name); len(words) > 1 {
+				types = append(types, words)
+			}
+		}
+	}
+	sort.Slice(types, func(i, j int) bool {
+		if len(types[i]) != len(types[j]) {
+			return len(types[i]) > len(types[j])
+		}
+		return strings.Join(types[i], " ") < strings.Join(types[j], " ")
+	})
+	multiWordTypes = types
+}
+
+// summaryKey returns the key of the total represented by the given label,
+// such as "ミルク合計" and "Formula total".
+func summaryKey(label string) string {
+	label = strings.TrimSpace(label)
+	for _, l := range locales {
+		name := label
+		if n := len(name) - len(l.Total); n >= 0 && strings.EqualFold(name[n:], l.Total) {
+			name = strings.TrimSpace(name[:n])
+		}
+		if key, ok := l.Summary[name]; ok {
+			return key
+		}
+	}
+	return ""
+}
+
+func init() {
+	for _, l := range []Locale{localeJa, localeEn} {
+		if err := RegisterLocale(l); err != nil {
+			panic(err)
+		}
+	}
+}
+
+var localeJa = Locale{
+	Tag:         language.Japanese,
+	Header:      piyologJa,
+	DateLayout:  "2006/1/2",
+	DateFormat:  "%s(%s)",
+	Weekdays:    [7]string{"日", "月", "火", "水", "木", "金", "土"},
+	MonthLayout: "2006年1月",
+	Age:         "%d歳%dか月%d日",
+	Types: map[string]Kind{
+		"母乳":   KindNursing,
+		"ミルク":  KindFormula,
+		"離乳食":  KindSolid,
+		"寝る":   KindSleep,
+		"起きる":  KindWakeUp,
+		"おしっこ": KindPee,
+		"うんち":  KindPoop,
+		"お風呂":  KindBaths,
+		"体温":   KindBodyTemperature,
+		"搾母乳":  KindPumpedMilk,
+		"飲み物":  KindDrink,
+		"おやつ":  KindSnack,
+		"身長":   KindHeight,
+		"体重":   KindWeight,
+		"頭囲":   KindHeadCircumference,
+		"薬":    KindMedicine,
+		"病院":   KindHospital,
+		"予防接種": KindVaccination,
+		"嘔吐":   KindVomit,
+		"せき":   KindCough,
+		"発疹":   KindRash,
+		"ケガ":   KindInjury,
+		"散歩":   KindWalk,
+		"搾乳":   KindPumping,
+		"日記":   KindMemo,
+	},
+	Total: "合計",
+	Summary: map[string]string{
+		"母乳":   summaryNursing,
+		"ミルク":  summaryFormula,
+		"搾母乳":  summaryPumpedMilk,
+		"離乳食":  summarySolid,
+		"睡眠":   summarySleep,
+		"おしっこ": summaryPee,
+		"うんち":  summaryPoop,
+	},
+	LogHeader: []string{"日付", "時刻", "名前", "種類", "量", "単位", "時間(分)", "体温", "左(分)", "右(分)", "メモ"},
+	SummaryHeader: []string{"日付", "名前", "母乳 左(分)", "母乳 右(分)", "ミルク 回数", "ミルク 量", "ミルク 単位",
+		"搾母乳 回数", "搾母乳 量", "搾母乳 単位", "離乳食 回数", "睡眠(分)", "おしっこ 回数", "うんち 回数"},
+}
+
+var localeEn = Locale{
+	Tag:         language.English,
+	Header:      piyologEn,
+	DateLayout:  "Jan 2, 2006",
+	DateFormat:  "%[2]s, %[1]s",
+	Weekdays:    [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	MonthLayout: "Jan 2006",
+	Age:         "%dy%dm%dd",
+	Types: map[string]Kind{
+		"Nursing":     KindNursing,
+		"Formula":     KindFormula,
+		"Solid":       KindSolid,
+		"Sleep":       KindSleep,
+		"Wake-up":     KindWakeUp,
+		"Pee":         KindPee,
+		"Poop":        KindPoop,
+		"Baths":       KindBaths,
+		"Body Temp.":  KindBodyTemperature,
+		"Pumped milk": KindPumpedMilk,
+		"Drink":       KindDrink,
+		"Snack":       KindSnack,
+		"Height":      KindHeight,
+		"Weight":      KindWeight,
+		"Head circ.":  KindHeadCircumference,
+		"Medicine":    KindMedicine,
+		"Hospital":    KindHospital,
+		"Vaccination": KindVaccination,
+		"Vomit":       KindVomit,
+		"Cough":       KindCough,
+		"Rash":        KindRash,
+		"Injury":      KindInjury,
+		"Walk":        KindWalk,
+		"Pumping":     KindPumping,
+		"Memo":        KindMemo,
+	},
+	Total: "total",
+	Summary: map[string]string{
+		"Nursing":     summaryNursing,
+		"Formula":     summaryFormula,
+		"Pumped milk": summaryPumpedMilk,
+		"Pumped Milk": summaryPumpedMilk,
+		"Solid":       summarySolid,
+		"Solids":      summarySolid,
+		"Sleep":       summarySleep,
+		"Pee":         summaryPee,
+		"Poop":        summaryPoop,
+	},
+}
@@ -0,0 +1,347 @@
+package piyolog
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/text/language"
+)
+
+// registerLocales registers the locales during the test.
+func registerLocales(t *testing.T, ls ...Locale) {
+	t.Helper()
+	saved := slices.Clone(locales)
+	t.Cleanup(func() {
+		locales = saved
+		updateMultiWordTypes()
+	})
+	for _, l := range ls {
+		if err := RegisterLocale(l); err != nil {
+			t.Fatalf("unexpected error returned: %v", err)
+		}
+	}
+}
+
+func Test_Locale(t *testing.T) {
+	registerLocales(t, localeZhHant, localeZhHans, localeKo)
+	tests := []struct {
+		name string
+		in   string
+		tag  language.Tag
+		baby string
+		logs []string
+	}{
+		{
+			name: "traditional chinese",
+			in: `【PiyoLog】2024/8/1(週四)
+小花 (0歲3個月0天)
+
+08:00   配方奶 120ml   
+09:00   母乳 左 7分 / 右 5分   
+10:00   便便   
+12:00   起床 (1小時30分)   
+
+配方奶合計   1次 120ml
+睡眠合計   1小時30分
+`,
+			tag:  language.TraditionalChinese,
+			baby: "2024-05-01",
+			logs: []string{"formula", "nursing", "poop", "wake-up"},
+		},
+		{
+			name: "simplified chinese",
+			in: `【PiyoLog】2024/8/1(周四)
+小花 (0岁3个月0天)
+
+08:00   配方奶 120ml   
+12:00   起床 (1小时30分)   
+
+配方奶合计   1次 120ml
+`,
+			tag:  language.SimplifiedChinese,
+			baby: "2024-05-01",
+			logs: []string{"formula", "wake-up"},
+		},
+		{
+			name: "korean",
+			in: `[피요로그]2024/8/1(목)
+하나 (0세 3개월 0일)
+
+08:00   분유 120ml   
+09:00   모유 왼쪽 7분 / 오른쪽 5분   
+10:00   유축 모유 60ml   
+12:00   기상 (1시간30분)   
+
+분유 합계   1회 120ml
+수면 합계   1시간30분
+`,
+			tag:  language.Korean,
+			baby: "2024-05-01",
+			logs: []string{"formula", "nursing", "pumped-milk", "wake-up"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, warnings, err := ParseOptions{Strict: true}.Parse(tt.in)
+			if err != nil || len(warnings) != 0 {
+				t.Fatalf("unexpected error returned: %v %v", err, warnings)
+			}
+			if data.Tag != tt.tag {
+				t.Errorf("Tag = %v, want %v", data.Tag, tt.tag)
+			}
+			e := data.Entries[0]
+			if e.Baby == nil || e.Baby.DateOfBirth.Format(time.DateOnly) != tt.baby {
+				t.Errorf("Baby = %v, want born on %s", e.Baby, tt.baby)
+			}
+			var logs []string
+			for _, l := range e.Logs {
+				logs = append(logs, l.Kind().String())
+			}
+			if diff := cmp.Diff(tt.logs, logs); diff != "" {
+				t.Errorf("Logs failure: %s", diff)
+			}
+			if e.Summary.FormulaCount != 1 || e.Summary.FormulaVolume.Value != 120 {
+				t.Errorf("Summary = %+v", e.Summary)
+			}
+
+			// export data must be written in the same locale.
+			b, err := Marshal(data)
+			if err != nil {
+				t.Fatalf("unexpected error returned: %v", err)
+			}
+			if diff := cmp.Diff(tt.in, string(b)); diff != "" {
+				t.Errorf("Marshal failure: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_RegisterLocale(t *testing.T) {
+	// restore the locales not to affect the other tests.
+	registerLocales(t)
+	l := Locale{
+		Tag:         language.German,
+		Header:      "[PiyoLog DE]",
+		DateLayout:  "2.1.2006",
+		DateFormat:  "%[2]s, %[1]s",
+		Weekdays:    [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+		MonthLayout: "01.2006",
+		Age:         "%dJ%dM%dT",
+		Types:       map[string]Kind{"Flasche": KindFormula, "Abgepumpte Milch": KindPumpedMilk},
+		Total:       "gesamt",
+		Summary:     map[string]string{"Flasche": summaryFormula},
+	}
+	if err := RegisterLocale(l); err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	if _, ok := LookupLocale(language.German); !ok {
+		t.Errorf("German locale must be registered")
+	}
+	data, err := Parse(`[PiyoLog DE]Do, 1.8.2024
+Hana (0J3M0T)
+
+08:00   Flasche 120ml   
+09:00   Abgepumpte Milch 60ml   
+
+Flasche gesamt   1 times 120ml
+`)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	if data.Tag != language.German || len(data.Entries) != 1 {
+		t.Fatalf("unexpected data: %+v", data)
+	}
+	e := data.Entries[0]
+	if _, ok := e.Logs[0].(FormulaLog); !ok || e.Baby == nil || e.Summary.FormulaVolume.Value != 120 {
+		t.Errorf("unexpected entry: %+v", e)
+	}
+	if v, ok := e.Logs[1].(PumpedMilkLog); !ok || v.Volume.Value != 60 {
+		t.Errorf("the type of multiple words must be recognised: %+v", e.Logs[1])
+	}
+
+	l.Age = "%d"
+	if err := RegisterLocale(l); err == nil {
+		t.Errorf("error must be returned for the invalid age")
+	}
+}
+
+func Test_LocaleMonthly(t *testing.T) {
+	registerLocales(t, localeZhHant, localeZhHans)
+	in := `【PiyoLog】2024年8月
+----------
+2024/8/1(周四)
+小花 (0岁3个月0天)
+
+08:00   配方奶 120ml   
+
+配方奶合计   1次 120ml
+
+----------
+2024/8/2(周五)
+小花 (0岁3个月1天)
+
+08:00   配方奶 100ml   
+----------
+`
+	data, warnings, err := ParseOptions{Strict: true}.Parse(in)
+	if err != nil || len(warnings) != 0 {
+		t.Fatalf("unexpected error returned: %v %v", err, warnings)
+	}
+	if data.Tag != language.SimplifiedChinese || !data.Monthly || len(data.Entries) != 2 {
+		t.Errorf("unexpected data: %+v", data)
+	}
+	b, err := Marshal(data)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	if s := string(b); !strings.Contains(s, "2024/8/1(周四)") || strings.Contains(s, "週") {
+		t.Errorf("Marshal = %s", s)
+	}
+}
+
+// The locales of Chinese and Korean are not verified with real export data,
+// but they are examples of the locales which share a header.
+
+var localeZhHant = Locale{
+	Tag:         language.TraditionalChinese,
+	Header:      "【PiyoLog】",
+	DateLayout:  "2006/1/2",
+	DateFormat:  "%s(%s)",
+	Weekdays:    [7]string{"週日", "週一", "週二", "週三", "週四", "週五", "週六"},
+	MonthLayout: "2006年1月",
+	Age:         "%d歲%d個月%d天",
+	Types: map[string]Kind{
+		"母乳":    KindNursing,
+		"配方奶":   KindFormula,
+		"副食品":   KindSolid,
+		"睡覺":    KindSleep,
+		"起床":    KindWakeUp,
+		"尿尿":    KindPee,
+		"便便":    KindPoop,
+		"洗澡":    KindBaths,
+		"體溫":    KindBodyTemperature,
+		"擠出的母乳": KindPumpedMilk,
+		"飲料":    KindDrink,
+		"點心":    KindSnack,
+		"身高":    KindHeight,
+		"體重":    KindWeight,
+		"頭圍":    KindHeadCircumference,
+		"藥":     KindMedicine,
+		"醫院":    KindHospital,
+		"預防接種":  KindVaccination,
+		"嘔吐":    KindVomit,
+		"咳嗽":    KindCough,
+		"疹子":    KindRash,
+		"受傷":    KindInjury,
+		"散步":    KindWalk,
+		"擠奶":    KindPumping,
+		"日記":    KindMemo,
+	},
+	Total: "合計",
+	Summary: map[string]string{
+		"母乳":    summaryNursing,
+		"配方奶":   summaryFormula,
+		"擠出的母乳": summaryPumpedMilk,
+		"副食品":   summarySolid,
+		"睡眠":    summarySleep,
+		"尿尿":    summaryPee,
+		"便便":    summaryPoop,
+	},
+}
+
+var localeZhHans = Locale{
+	Tag:         language.SimplifiedChinese,
+	Header:      "【PiyoLog】",
+	DateLayout:  "2006/1/2",
+	DateFormat:  "%s(%s)",
+	Weekdays:    [7]string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"},
+	MonthLayout: "2006年1月",
+	Age:         "%d岁%d个月%d天",
+	Types: map[string]Kind{
+		"母乳":    KindNursing,
+		"配方奶":   KindFormula,
+		"辅食":    KindSolid,
+		"睡觉":    KindSleep,
+		"起床":    KindWakeUp,
+		"小便":    KindPee,
+		"大便":    KindPoop,
+		"洗澡":    KindBaths,
+		"体温":    KindBodyTemperature,
+		"挤出的母乳": KindPumpedMilk,
+		"饮料":    KindDrink,
+		"点心":    KindSnack,
+		"身高":    KindHeight,
+		"体重":    KindWeight,
+		"头围":    KindHeadCircumference,
+		"药":     KindMedicine,
+		"医院":    KindHospital,
+		"预防接种":  KindVaccination,
+		"呕吐":    KindVomit,
+		"咳嗽":    KindCough,
+		"疹子":    KindRash,
+		"受伤":    KindInjury,
+		"散步":    KindWalk,
+		"挤奶":    KindPumping,
+		"日记":    KindMemo,
+	},
+	Total: "合计",
+	Summary: map[string]string{
+		"母乳":    summaryNursing,
+		"配方奶":   summaryFormula,
+		"挤出的母乳": summaryPumpedMilk,
+		"辅食":    summarySolid,
+		"睡眠":    summarySleep,
+		"小便":    summaryPee,
+		"大便":    summaryPoop,
+	},
+}
+
+var localeKo = Locale{
+	Tag:         language.Korean,
+	Header:      "[피요로그]",
+	DateLayout:  "2006/1/2",
+	DateFormat:  "%s(%s)",
+	Weekdays:    [7]string{"일", "월", "화", "수", "목", "금", "토"},
+	MonthLayout: "2006년 1월",
+	Age:         "%d세 %d개월 %d일",
+	Types: map[string]Kind{
+		"모유":    KindNursing,
+		"분유":    KindFormula,
+		"이유식":   KindSolid,
+		"잠":     KindSleep,
+		"기상":    KindWakeUp,
+		"소변":    KindPee,
+		"대변":    KindPoop,
+		"목욕":    KindBaths,
+		"체온":    KindBodyTemperature,
+		"유축 모유": KindPumpedMilk,
+		"음료":    KindDrink,
+		"간식":    KindSnack,
+		"키":     KindHeight,
+		"몸무게":   KindWeight,
+		"머리둘레":  KindHeadCircumference,
+		"약":     KindMedicine,
+		"병원":    KindHospital,
+		"예방접종":  KindVaccination,
+		"구토":    KindVomit,
+		"기침":    KindCough,
+		"발진":    KindRash,
+		"부상":    KindInjury,
+		"산책":    KindWalk,
+		"유축":    KindPumping,
+		"일기":    KindMemo,
+	},
+	Total: "합계",
+	Summary: map[string]string{
+		"모유":    summaryNursing,
+		"분유":    summaryFormula,
+		"유축 모유": summaryPumpedMilk,
+		"이유식":   summarySolid,
+		"수면":    summarySleep,
+		"소변":    summaryPee,
+		"대변":    summaryPoop,
+	},
+}
@@ -3,6 +3,7 @@ package piyolog
 import (
 	"fmt"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 )
 
 type Log interface {
+	Kind() Kind
 	Type() string
 	Content() string
 	Notes() string
@@ -18,14 +20,16 @@ type Log interface {
 	String() string
 }
 
-// NewLog returns a log interface.
-func NewLog(str string, date time.Time) Log {
+// NewLog returns a log interface created at the time on the date in the location of the date.
+// It returns ErrInvalidLog if str is not a log. If the content of the log
+// cannot be parsed, it returns the log as a LogItem value with the error.
+func NewLog(str string, date time.Time) (Log, error) {
 	tm, typ, content, notes := SplitLog(str)
 	if tm.IsZero() {
-		return nil
+		return nil, ErrInvalidLog
 	}
 	createdAt := time.Date(date.Year(), date.Month(), date.Day(),
-		tm.Hour(), tm.Minute(), 0, 0, piyoLoc)
+		tm.Hour(), tm.Minute(), 0, 0, date.Location())
 	return NewLogItem(typ, content, notes, createdAt).Log()
 }
 
@@ -40,9 +44,19 @@ func SplitLog(str string) (time.Time, string, string, string) {
 	}
 	tm := piyologutil.ParseTime(split[0])
 	fields := strings.Fields(split[1])
+	if len(fields) == 0 {
+		return time.Time{}, "", "", ""
+	}
+	n := 1
+	for _, words := range multiWordTypes {
+		if len(fields) >= len(words) && slices.Equal(fields[:len(words)], words) {
+			n = len(words)
+			break
+		}
+	}
 	return tm,
-		fields[0],
-		strings.Join(fields[1:], ` `),
+		strings.Join(fields[:n], ` `),
+		strings.Join(fields[n:], ` `),
 		strings.Join(split[2:], logSeparator)
 }
 
@@ -63,28 +77,76 @@ func NewLogItem(typ, content, notes string, createdAt time.Time) LogItem {
 	}
 }
 
-func (i LogItem) Log() Log {
-	switch i.typ {
-	case "母乳", "Nursing":
-		return NewNursingLog(i)
-	case "ミルク", "Formula":
-		return NewFormulaLog(i)
-	case "離乳食", "Solid":
-		return NewSolidLog(i)
-	case "寝る", "Sleep":
-		return NewSleepLog(i)
-	case "起きる", "Wake-up":
-		return NewWakeUpLog(i)
-	case "おしっこ", "Pee":
-		return NewPeeLog(i)
-	case "うんち", "Poop":
-		return NewPoopLog(i)
-	case "お風呂", "Baths":
-		return NewBathsLog(i)
-	case "体温", "Body Temp.":
-		return NewBodyTemperatureLog(i)
+// Log returns the log value typed by its type. If the content of the log
+// cannot be parsed, it returns i itself with the error.
+func (i LogItem) Log() (Log, error) {
+	var (
+		l   Log
+		err error
+	)
+	switch i.Kind() {
+	case KindNursing:
+		l, err = NewNursingLog(i)
+	case KindFormula:
+		l, err = NewFormulaLog(i)
+	case KindSolid:
+		l = NewSolidLog(i)
+	case KindSleep:
+		l = NewSleepLog(i)
+	case KindWakeUp:
+		l = NewWakeUpLog(i)
+	case KindPee:
+		l = NewPeeLog(i)
+	case KindPoop:
+		l = NewPoopLog(i)
+	case KindBaths:
+		l = NewBathsLog(i)
+	case KindBodyTemperature:
+		l, err = NewBodyTemperatureLog(i)
+	case KindPumpedMilk:
+		l, err = NewPumpedMilkLog(i)
+	case KindDrink:
+		l, err = NewDrinkLog(i)
+	case KindSnack:
+		l = NewSnackLog(i)
+	case KindHeight:
+		l, err = NewHeightLog(i)
+	case KindWeight:
+		l, err = NewWeightLog(i)
+	case KindHeadCircumference:
+		l, err = NewHeadCircumferenceLog(i)
+	case KindMedicine:
+		l = NewMedicineLog(i)
+	case KindHospital:
+		l = NewHospitalLog(i)
+	case KindVaccination:
+		l = NewVaccinationLog(i)
+	case KindVomit:
+		l = NewVomitLog(i)
+	case KindCough:
+		l = NewCoughLog(i)
+	case KindRash:
+		l = NewRashLog(i)
+	case KindInjury:
+		l = NewInjuryLog(i)
+	case KindWalk:
+		l, err = NewWalkLog(i)
+	case KindPumping:
+		l, err = NewPumpingLog(i)
+	case KindMemo:
+		l = NewMemoLog(i)
+	default:
+		l = i
 	}
-	return i
+	if err != nil {
+		return i, err
+	}
+	return l, nil
+}
+
+// Kind returns the kind of the log interpreted by its type regardless of the language.
+func (i LogItem) Kind() Kind {
+	return lookupKind(i.typ)
 }
 
 func (i LogItem) Type() string {
@@ -107,47 +169,120 @@ func (i LogItem) String() string {
 	return strings.TrimSpace(fmt.Sprintf("%s %s %s %s", i.createdAt.Format("15:04"), i.typ, i.content, i.notes))
 }
 
-var reAmount = regexp.MustCompile(`^([0-9]+)(.+)$`)
+var reAmount = regexp.MustCompile(`^[0-9]`)
+
+// Side represents a side of breasts.
+type Side int
 
-func amountAndUnit(str string) (int, string) {
-	sm := reAmount.FindStringSubmatch(str)
-	amount, _ := strconv.Atoi(sm[1])
-	return amount, sm[2]
+const (
+	SideNone Side = iota
+	SideLeft
+	SideRight
+)
+
+func (s Side) String() string {
+	switch s {
+	case SideLeft:
+		return "left"
+	case SideRight:
+		return "right"
+	}
+	return ""
 }
 
 type NursingLog struct {
 	LogItem
-	Left   time.Duration // TODO
-	Right  time.Duration // TODO
-	Amount int
-	Unit   string
+	Left     time.Duration
+	Right    time.Duration
+	LastSide Side
+	Volume   Volume
 }
 
+var (
+	reDuration = regexp.MustCompile(`^([0-9]+(時間|小時|小时|시간|h))?([0-9]+(分|분|m))?([0-9]+(秒|초|s))?$`)
+	// lastSideMarkers are the marks PiyoLog puts on the side used last.
+	lastSideMarkers = []string{"←", "→", "<-", "->"}
+)
+
 // NewNursingLog returns a NursingLog value.
-func NewNursingLog(i LogItem) NursingLog {
-	f := strings.Fields(i.content)
-	amount, unit := amountAndUnit(strings.Trim(f[len(f)-1], "()"))
-	return NursingLog{
+// It parses the content such as "左 7分 / 右 5分 (50ml)" and "Left 7m / Right 5m".
+func NewNursingLog(i LogItem) (NursingLog, error) {
+	l := NursingLog{
 		LogItem: i,
-		Amount:  amount,
-		Unit:    unit,
 	}
+	content, volume, err := trailingVolume(i.content)
+	if err != nil {
+		return l, err
+	}
+	l.Volume = volume
+	l.Left, l.Right, l.LastSide, err = nursingSides(content)
+	return l, err
+}
+
+// trailingVolume returns the volume written in parentheses at the tail of the
+// given string, such as "(50ml)", and the rest of the string.
+func trailingVolume(str string) (string, Volume, error) {
+	idx := strings.LastIndex(str, "(")
+	if idx < 0 || !strings.HasSuffix(str, ")") {
+		return str, Volume{}, nil
+	}
+	volume, err := ParseVolume(str[idx+1 : len(str)-1])
+	if err != nil {
+		return str, Volume{}, err
+	}
+	return str[:idx], volume, nil
+}
+
+// nursingSides returns the durations of both sides and the side used last
+// interpreted by the given string, such as "左 7分 / 右 5分".
+func nursingSides(str string) (left, right time.Duration, lastSide Side, err error) {
+	for _, seg := range strings.Split(str, "/") {
+		f := strings.Fields(seg)
+		if len(f) == 0 {
+			continue
+		}
+		last := false
+		dur := strings.Join(f[1:], "")
+		for _, m := range lastSideMarkers {
+			if strings.Contains(dur, m) {
+				dur = strings.ReplaceAll(dur, m, "")
+				last = true
+			}
+		}
+		if !reDuration.MatchString(dur) {
+			return 0, 0, SideNone, fmt.Errorf("%w: no duration in %q", ErrInvalidContent, seg)
+		}
+		d := piyologutil.ParseDuration(dur)
+		var side Side
+		switch f[0] {
+		case "左", "Left", "L", "왼쪽":
+			side = SideLeft
+			left = d
+		case "右", "Right", "R", "오른쪽":
+			side = SideRight
+			right = d
+		default:
+			return 0, 0, SideNone, fmt.Errorf("%w: unknown side in %q", ErrInvalidContent, seg)
+		}
+		if last {
+			lastSide = side
+		}
+	}
+	return left, right, lastSide, nil
 }
 
 type FormulaLog struct {
 	LogItem
-	Amount int
-	Unit   string
+	Volume Volume
 }
 
 // NewFormulaLog returns a FormulaLog value.
-func NewFormulaLog(i LogItem) FormulaLog {
-	amount, unit := amountAndUnit(i.content)
+func NewFormulaLog(i LogItem) (FormulaLog, error) {
+	volume, err := ParseVolume(i.content)
 	return FormulaLog{
 		LogItem: i,
-		Amount:  amount,
-		Unit:    unit,
-	}
+		Volume:  volume,
+	}, err
 }
 
 type SolidLog struct {
@@ -188,23 +323,36 @@ func NewWakeUpLog(i LogItem) WakeUpLog {
 
 type PeeLog struct {
 	LogItem
+	Amount Amount
 }
 
 // NewPeeLog returns a PeeLog value.
+// The amount is read from the content and notes such as "(多め)".
 func NewPeeLog(i LogItem) PeeLog {
+	amount, _, _ := excretion(i)
 	return PeeLog{
 		LogItem: i,
+		Amount:  amount,
 	}
 }
 
 type PoopLog struct {
 	LogItem
+	Amount      Amount
+	Consistency Consistency
+	Color       Color
 }
 
 // NewPoopLog returns a PoopLog value.
+// The attributes are read from the content and notes such as "(少なめ/ふつう/緑)".
+// Descriptors which are not known are ignored.
 func NewPoopLog(i LogItem) PoopLog {
+	amount, consistency, color := excretion(i)
 	return PoopLog{
-		LogItem: i,
+		LogItem:     i,
+		Amount:      amount,
+		Consistency: consistency,
+		Color:       color,
 	}
 }
 
@@ -221,19 +369,259 @@ func NewBathsLog(i LogItem) BathsLog {
 
 type BodyTemperatureLog struct {
 	LogItem
-	Temperature float64
-	Unit        string
+	Temperature Temperature
 }
 
-var reBodyTemperature = regexp.MustCompile(`([0-9\.]+)(.+)`)
+var reMeasurement = regexp.MustCompile(`([0-9\.]+)(.+)`)
+
+// measurementAndUnit returns the value and the unit interpreted by the given string,
+// such as "36.5°C" and "6.5kg".
+func measurementAndUnit(str string) (float64, string, error) {
+	sm := reMeasurement.FindStringSubmatch(str)
+	if sm == nil {
+		return 0, "", fmt.Errorf("%w: no measurement in %q", ErrInvalidContent, str)
+	}
+	v, err := strconv.ParseFloat(sm[1], 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("%w: %w", ErrInvalidContent, err)
+	}
+	return v, strings.TrimSpace(sm[2]), nil
+}
 
 // NewBodyTemperatureLog returns a BodyTemperatureLog value.
-func NewBodyTemperatureLog(i LogItem) BodyTemperatureLog {
-	sm := reBodyTemperature.FindStringSubmatch(i.content)
-	temp, _ := strconv.ParseFloat(sm[1], 64)
+func NewBodyTemperatureLog(i LogItem) (BodyTemperatureLog, error) {
+	temp, err := ParseTemperature(i.content)
 	return BodyTemperatureLog{
 		LogItem:     i,
 		Temperature: temp,
-		Unit:        sm[2],
+	}, err
+}
+
+type PumpedMilkLog struct {
+	LogItem
+	Volume Volume
+}
+
+// NewPumpedMilkLog returns a PumpedMilkLog value.
+func NewPumpedMilkLog(i LogItem) (PumpedMilkLog, error) {
+	volume, err := ParseVolume(i.content)
+	return PumpedMilkLog{
+		LogItem: i,
+		Volume:  volume,
+	}, err
+}
+
+type DrinkLog struct {
+	LogItem
+	Volume Volume
+}
+
+// NewDrinkLog returns a DrinkLog value.
+// The amount is optional since PiyoLog does not require it.
+func NewDrinkLog(i LogItem) (DrinkLog, error) {
+	l := DrinkLog{
+		LogItem: i,
+	}
+	if i.content == "" {
+		return l, nil
+	}
+	var err error
+	l.Volume, err = ParseVolume(i.content)
+	return l, err
+}
+
+type SnackLog struct {
+	LogItem
+}
+
+// NewSnackLog returns a SnackLog value.
+func NewSnackLog(i LogItem) SnackLog {
+	return SnackLog{
+		LogItem: i,
+	}
+}
+
+type HeightLog struct {
+	LogItem
+	Height float64
+	Unit   string
+}
+
+// NewHeightLog returns a HeightLog value.
+func NewHeightLog(i LogItem) (HeightLog, error) {
+	height, unit, err := measurementAndUnit(i.content)
+	return HeightLog{
+		LogItem: i,
+		Height:  height,
+		Unit:    unit,
+	}, err
+}
+
+type WeightLog struct {
+	LogItem
+	Weight float64
+	Unit   string
+}
+
+// NewWeightLog returns a WeightLog value.
+func NewWeightLog(i LogItem) (WeightLog, error) {
+	weight, unit, err := measurementAndUnit(i.content)
+	return WeightLog{
+		LogItem: i,
+		Weight:  weight,
+		Unit:    unit,
+	}, err
+}
+
+type HeadCircumferenceLog struct {
+	LogItem
+	Circumference float64
+	Unit          string
+}
+
+// NewHeadCircumferenceLog returns a HeadCircumferenceLog value.
+func NewHeadCircumferenceLog(i LogItem) (HeadCircumferenceLog, error) {
+	circ, unit, err := measurementAndUnit(i.content)
+	return HeadCircumferenceLog{
+		LogItem:       i,
+		Circumference: circ,
+		Unit:          unit,
+	}, err
+}
+
+type MedicineLog struct {
+	LogItem
+}
+
+// NewMedicineLog returns a MedicineLog value.
+func NewMedicineLog(i LogItem) MedicineLog {
+	return MedicineLog{
+		LogItem: i,
+	}
+}
+
+type HospitalLog struct {
+	LogItem
+}
+
+// NewHospitalLog returns a HospitalLog value.
+func NewHospitalLog(i LogItem) HospitalLog {
+	return HospitalLog{
+		LogItem: i,
+	}
+}
+
+type VaccinationLog struct {
+	LogItem
+}
+
+// NewVaccinationLog returns a VaccinationLog value.
+func NewVaccinationLog(i LogItem) VaccinationLog {
+	return VaccinationLog{
+		LogItem: i,
+	}
+}
+
+type VomitLog struct {
+	LogItem
+}
+
+// NewVomitLog returns a VomitLog value.
+func NewVomitLog(i LogItem) VomitLog {
+	return VomitLog{
+		LogItem: i,
+	}
+}
+
+type CoughLog struct {
+	LogItem
+}
+
+// NewCoughLog returns a CoughLog value.
+func NewCoughLog(i LogItem) CoughLog {
+	return CoughLog{
+		LogItem: i,
+	}
+}
+
+type RashLog struct {
+	LogItem
+}
+
+// NewRashLog returns a RashLog value.
+func NewRashLog(i LogItem) RashLog {
+	return RashLog{
+		LogItem: i,
+	}
+}
+
+type InjuryLog struct {
+	LogItem
+}
+
+// NewInjuryLog returns an InjuryLog value.
+func NewInjuryLog(i LogItem) InjuryLog {
+	return InjuryLog{
+		LogItem: i,
+	}
+}
+
+type WalkLog struct {
+	LogItem
+	Duration time.Duration
+}
+
+// NewWalkLog returns a WalkLog value.
+// The duration is optional, such as "(30分)".
+func NewWalkLog(i LogItem) (WalkLog, error) {
+	l := WalkLog{
+		LogItem: i,
+	}
+	if i.content == "" {
+		return l, nil
+	}
+	content := strings.Trim(i.content, "()")
+	if !reDuration.MatchString(content) {
+		return l, fmt.Errorf("%w: no duration in %q", ErrInvalidContent, i.content)
+	}
+	l.Duration = piyologutil.ParseDuration(content)
+	return l, nil
+}
+
+type PumpingLog struct {
+	LogItem
+	Left   time.Duration
+	Right  time.Duration
+	Volume Volume
+}
+
+// NewPumpingLog returns a PumpingLog value.
+// It parses the content such as "80ml" and "左 10分 / 右 10分 (80ml)".
+func NewPumpingLog(i LogItem) (PumpingLog, error) {
+	l := PumpingLog{
+		LogItem: i,
+	}
+	if reAmount.MatchString(i.content) {
+		var err error
+		l.Volume, err = ParseVolume(i.content)
+		return l, err
+	}
+	content, volume, err := trailingVolume(i.content)
+	if err != nil {
+		return l, err
+	}
+	l.Volume = volume
+	l.Left, l.Right, _, err = nursingSides(content)
+	return l, err
+}
+
+type MemoLog struct {
+	LogItem
+}
+
+// NewMemoLog returns a MemoLog value.
+func NewMemoLog(i LogItem) MemoLog {
+	return MemoLog{
+		LogItem: i,
 	}
 }
@@ -1,6 +1,7 @@
 package piyolog
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -27,10 +28,47 @@ func Test_Log(t *testing.T) {
 					notes:     "たくさん飲んだ",
 					createdAt: createdAt(23, 00),
 				},
-				Amount: 50,
-				Unit:   "ml",
+				Left:   time.Duration(7) * time.Minute,
+				Right:  time.Duration(5) * time.Minute,
+				Volume: Volume{Value: 50, Unit: Milliliter},
 			},
 			str: `23:00 母乳 左 7分 / 右 5分 (50ml) たくさん飲んだ`,
+		}, {
+			in: `11:45   母乳 左 1時間5分30秒 ←   `,
+			out: NursingLog{
+				LogItem: LogItem{
+					typ:       "母乳",
+					content:   "左 1時間5分30秒 ←",
+					createdAt: createdAt(11, 45),
+				},
+				Left:     time.Duration(1)*time.Hour + time.Duration(5)*time.Minute + time.Duration(30)*time.Second,
+				LastSide: SideLeft,
+			},
+			str: `11:45 母乳 左 1時間5分30秒 ←`,
+		}, {
+			in: `09:15 PM   Nursing Left 7m / Right 5m ->   `,
+			out: NursingLog{
+				LogItem: LogItem{
+					typ:       "Nursing",
+					content:   "Left 7m / Right 5m ->",
+					createdAt: createdAt(21, 15),
+				},
+				Left:     time.Duration(7) * time.Minute,
+				Right:    time.Duration(5) * time.Minute,
+				LastSide: SideRight,
+			},
+			str: `21:15 Nursing Left 7m / Right 5m ->`,
+		}, {
+			in: `09:20 PM   Nursing Right 10m   `,
+			out: NursingLog{
+				LogItem: LogItem{
+					typ:       "Nursing",
+					content:   "Right 10m",
+					createdAt: createdAt(21, 20),
+				},
+				Right: time.Duration(10) * time.Minute,
+			},
+			str: `21:20 Nursing Right 10m`,
 		}, {
 			in: `08:45 AM   ミルク 140ml   たくさん    飲んだ`,
 			out: FormulaLog{
@@ -40,8 +78,7 @@ func Test_Log(t *testing.T) {
 					notes:     "たくさん    飲んだ",
 					createdAt: createdAt(8, 45),
 				},
-				Amount: 140,
-				Unit:   "ml",
+				Volume: Volume{Value: 140, Unit: Milliliter},
 			},
 			str: `08:45 ミルク 140ml たくさん    飲んだ`,
 		}, {
@@ -81,7 +118,7 @@ func Test_Log(t *testing.T) {
 		}, {
 			in: `06:40   おしっこ   `,
 			out: PeeLog{
-				LogItem{
+				LogItem: LogItem{
 					typ:       "おしっこ",
 					content:   "",
 					notes:     "",
@@ -92,14 +129,56 @@ func Test_Log(t *testing.T) {
 		}, {
 			in: `23:15   うんち (少なめ/ふつう/緑)   たくさん出た`,
 			out: PoopLog{
-				LogItem{
+				LogItem: LogItem{
 					typ:       "うんち",
 					content:   "(少なめ/ふつう/緑)",
 					notes:     "たくさん出た",
 					createdAt: createdAt(23, 15),
 				},
+				Amount:      AmountLittle,
+				Consistency: ConsistencyNormal,
+				Color:       ColorGreen,
 			},
 			str: `23:15 うんち (少なめ/ふつう/緑) たくさん出た`,
+		}, {
+			in: `07:20   うんち (多め/やわらかめ)   `,
+			out: PoopLog{
+				LogItem: LogItem{
+					typ:       "うんち",
+					content:   "(多め/やわらかめ)",
+					notes:     "",
+					createdAt: createdAt(7, 20),
+				},
+				Amount:      AmountLot,
+				Consistency: ConsistencySoft,
+			},
+			str: `07:20 うんち (多め/やわらかめ)`,
+		}, {
+			in: `07:30   Poop (Normal/Diarrhea)   black`,
+			out: PoopLog{
+				LogItem: LogItem{
+					typ:       "Poop",
+					content:   "(Normal/Diarrhea)",
+					notes:     "black",
+					createdAt: createdAt(7, 30),
+				},
+				Amount:      AmountNormal,
+				Consistency: ConsistencyDiarrhea,
+				Color:       ColorBlack,
+			},
+			str: `07:30 Poop (Normal/Diarrhea) black`,
+		}, {
+			in: `07:40   おしっこ (少なめ)   `,
+			out: PeeLog{
+				LogItem: LogItem{
+					typ:       "おしっこ",
+					content:   "(少なめ)",
+					notes:     "",
+					createdAt: createdAt(7, 40),
+				},
+				Amount: AmountLittle,
+			},
+			str: `07:40 おしっこ (少なめ)`,
 		}, {
 			in: `19:10   お風呂   `,
 			out: BathsLog{
@@ -120,15 +199,389 @@ func Test_Log(t *testing.T) {
 					notes:     "",
 					createdAt: createdAt(14, 30),
 				},
-				Temperature: 36.5,
-				Unit:        "°C",
+				Temperature: Temperature{Value: 36.5, Unit: Celsius},
 			},
 			str: `14:30 体温 36.5°C`,
+		}, {
+			in: `10:00   搾母乳 50ml   `,
+			out: PumpedMilkLog{
+				LogItem: LogItem{
+					typ:       "搾母乳",
+					content:   "50ml",
+					notes:     "",
+					createdAt: createdAt(10, 0),
+				},
+				Volume: Volume{Value: 50, Unit: Milliliter},
+			},
+			str: `10:00 搾母乳 50ml`,
+		}, {
+			in: `10:05 AM   Pumped milk 60ml   `,
+			out: PumpedMilkLog{
+				LogItem: LogItem{
+					typ:       "Pumped milk",
+					content:   "60ml",
+					notes:     "",
+					createdAt: createdAt(10, 5),
+				},
+				Volume: Volume{Value: 60, Unit: Milliliter},
+			},
+			str: `10:05 Pumped milk 60ml`,
+		}, {
+			in: `10:10   飲み物 30ml   麦茶`,
+			out: DrinkLog{
+				LogItem: LogItem{
+					typ:       "飲み物",
+					content:   "30ml",
+					notes:     "麦茶",
+					createdAt: createdAt(10, 10),
+				},
+				Volume: Volume{Value: 30, Unit: Milliliter},
+			},
+			str: `10:10 飲み物 30ml 麦茶`,
+		}, {
+			in: `10:15 AM   Drink   `,
+			out: DrinkLog{
+				LogItem: LogItem{
+					typ:       "Drink",
+					content:   "",
+					notes:     "",
+					createdAt: createdAt(10, 15),
+				},
+			},
+			str: `10:15 Drink`,
+		}, {
+			in: `15:00   おやつ   ボーロ`,
+			out: SnackLog{
+				LogItem: LogItem{
+					typ:       "おやつ",
+					content:   "",
+					notes:     "ボーロ",
+					createdAt: createdAt(15, 0),
+				},
+			},
+			str: `15:00 おやつ  ボーロ`,
+		}, {
+			in: `03:05 PM   Snack   `,
+			out: SnackLog{
+				LogItem: LogItem{
+					typ:       "Snack",
+					content:   "",
+					notes:     "",
+					createdAt: createdAt(15, 5),
+				},
+			},
+			str: `15:05 Snack`,
+		}, {
+			in: `09:00   身長 65.5cm   `,
+			out: HeightLog{
+				LogItem: LogItem{
+					typ:       "身長",
+					content:   "65.5cm",
+					notes:     "",
+					createdAt: createdAt(9, 0),
+				},
+				Height: 65.5,
+				Unit:   "cm",
+			},
+			str: `09:00 身長 65.5cm`,
+		}, {
+			in: `09:01 AM   Height 25.8in   `,
+			out: HeightLog{
+				LogItem: LogItem{
+					typ:       "Height",
+					content:   "25.8in",
+					notes:     "",
+					createdAt: createdAt(9, 1),
+				},
+				Height: 25.8,
+				Unit:   "in",
+			},
+			str: `09:01 Height 25.8in`,
+		}, {
+			in: `09:02   体重 6.52kg   `,
+			out: WeightLog{
+				LogItem: LogItem{
+					typ:       "体重",
+					content:   "6.52kg",
+					notes:     "",
+					createdAt: createdAt(9, 2),
+				},
+				Weight: 6.52,
+				Unit:   "kg",
+			},
+			str: `09:02 体重 6.52kg`,
+		}, {
+			in: `09:03 AM   Weight 14.4lb   `,
+			out: WeightLog{
+				LogItem: LogItem{
+					typ:       "Weight",
+					content:   "14.4lb",
+					notes:     "",
+					createdAt: createdAt(9, 3),
+				},
+				Weight: 14.4,
+				Unit:   "lb",
+			},
+			str: `09:03 Weight 14.4lb`,
+		}, {
+			in: `09:04   頭囲 41.2cm   `,
+			out: HeadCircumferenceLog{
+				LogItem: LogItem{
+					typ:       "頭囲",
+					content:   "41.2cm",
+					notes:     "",
+					createdAt: createdAt(9, 4),
+				},
+				Circumference: 41.2,
+				Unit:          "cm",
+			},
+			str: `09:04 頭囲 41.2cm`,
+		}, {
+			in: `09:05 AM   Head circ. 16.2in   `,
+			out: HeadCircumferenceLog{
+				LogItem: LogItem{
+					typ:       "Head circ.",
+					content:   "16.2in",
+					notes:     "",
+					createdAt: createdAt(9, 5),
+				},
+				Circumference: 16.2,
+				Unit:          "in",
+			},
+			str: `09:05 Head circ. 16.2in`,
+		}, {
+			in: `12:00   薬   解熱剤`,
+			out: MedicineLog{
+				LogItem: LogItem{
+					typ:       "薬",
+					content:   "",
+					notes:     "解熱剤",
+					createdAt: createdAt(12, 0),
+				},
+			},
+			str: `12:00 薬  解熱剤`,
+		}, {
+			in: `12:01 PM   Medicine   `,
+			out: MedicineLog{
+				LogItem: LogItem{
+					typ:       "Medicine",
+					content:   "",
+					notes:     "",
+					createdAt: createdAt(12, 1),
+				},
+			},
+			str: `12:01 Medicine`,
+		}, {
+			in: `13:00   病院   小児科`,
+			out: HospitalLog{
+				LogItem: LogItem{
+					typ:       "病院",
+					content:   "",
+					notes:     "小児科",
+					createdAt: createdAt(13, 0),
+				},
+			},
+			str: `13:00 病院  小児科`,
+		}, {
+			in: `01:01 PM   Hospital   `,
+			out: HospitalLog{
+				LogItem: LogItem{
+					typ:       "Hospital",
+					content:   "",
+					notes:     "",
+					createdAt: createdAt(13, 1),
+				},
+			},
+			str: `13:01 Hospital`,
+		}, {
+			in: `14:00   予防接種   ヒブ`,
+			out: VaccinationLog{
+				LogItem: LogItem{
+					typ:       "予防接種",
+					content:   "",
+					notes:     "ヒブ",
+					createdAt: createdAt(14, 0),
+				},
+			},
+			str: `14:00 予防接種  ヒブ`,
+		}, {
+			in: `02:01 PM   Vaccination   `,
+			out: VaccinationLog{
+				LogItem: LogItem{
+					typ:       "Vaccination",
+					content:   "",
+					notes:     "",
+					createdAt: createdAt(14, 1),
+				},
+			},
+			str: `14:01 Vaccination`,
+		}, {
+			in: `16:00   嘔吐   `,
+			out: VomitLog{
+				LogItem: LogItem{
+					typ:       "嘔吐",
+					content:   "",
+					notes:     "",
+					createdAt: createdAt(16, 0),
+				},
+			},
+			str: `16:00 嘔吐`,
+		}, {
+			in: `04:01 PM   Vomit   `,
+			out: VomitLog{
+				LogItem: LogItem{
+					typ:       "Vomit",
+					content:   "",
+					notes:     "",
+					createdAt: createdAt(16, 1),
+				},
+			},
+			str: `16:01 Vomit`,
+		}, {
+			in: `16:10   せき   `,
+			out: CoughLog{
+				LogItem: LogItem{
+					typ:       "せき",
+					content:   "",
+					notes:     "",
+					createdAt: createdAt(16, 10),
+				},
+			},
+			str: `16:10 せき`,
+		}, {
+			in: `04:11 PM   Cough   `,
+			out: CoughLog{
+				LogItem: LogItem{
+					typ:       "Cough",
+					content:   "",
+					notes:     "",
+					createdAt: createdAt(16, 11),
+				},
+			},
+			str: `16:11 Cough`,
+		}, {
+			in: `16:20   発疹   `,
+			out: RashLog{
+				LogItem: LogItem{
+					typ:       "発疹",
+					content:   "",
+					notes:     "",
+					createdAt: createdAt(16, 20),
+				},
+			},
+			str: `16:20 発疹`,
+		}, {
+			in: `04:21 PM   Rash   `,
+			out: RashLog{
+				LogItem: LogItem{
+					typ:       "Rash",
+					content:   "",
+					notes:     "",
+					createdAt: createdAt(16, 21),
+				},
+			},
+			str: `16:21 Rash`,
+		}, {
+			in: `16:30   ケガ   `,
+			out: InjuryLog{
+				LogItem: LogItem{
+					typ:       "ケガ",
+					content:   "",
+					notes:     "",
+					createdAt: createdAt(16, 30),
+				},
+			},
+			str: `16:30 ケガ`,
+		}, {
+			in: `04:31 PM   Injury   `,
+			out: InjuryLog{
+				LogItem: LogItem{
+					typ:       "Injury",
+					content:   "",
+					notes:     "",
+					createdAt: createdAt(16, 31),
+				},
+			},
+			str: `16:31 Injury`,
+		}, {
+			in: `17:00   散歩 (30分)   `,
+			out: WalkLog{
+				LogItem: LogItem{
+					typ:       "散歩",
+					content:   "(30分)",
+					notes:     "",
+					createdAt: createdAt(17, 0),
+				},
+				Duration: time.Duration(30) * time.Minute,
+			},
+			str: `17:00 散歩 (30分)`,
+		}, {
+			in: `05:01 PM   Walk   `,
+			out: WalkLog{
+				LogItem: LogItem{
+					typ:       "Walk",
+					content:   "",
+					notes:     "",
+					createdAt: createdAt(17, 1),
+				},
+			},
+			str: `17:01 Walk`,
+		}, {
+			in: `18:00   搾乳 左 10分 / 右 5分 (80ml)   `,
+			out: PumpingLog{
+				LogItem: LogItem{
+					typ:       "搾乳",
+					content:   "左 10分 / 右 5分 (80ml)",
+					notes:     "",
+					createdAt: createdAt(18, 0),
+				},
+				Left:   time.Duration(10) * time.Minute,
+				Right:  time.Duration(5) * time.Minute,
+				Volume: Volume{Value: 80, Unit: Milliliter},
+			},
+			str: `18:00 搾乳 左 10分 / 右 5分 (80ml)`,
+		}, {
+			in: `06:01 PM   Pumping 80ml   `,
+			out: PumpingLog{
+				LogItem: LogItem{
+					typ:       "Pumping",
+					content:   "80ml",
+					notes:     "",
+					createdAt: createdAt(18, 1),
+				},
+				Volume: Volume{Value: 80, Unit: Milliliter},
+			},
+			str: `18:01 Pumping 80ml`,
+		}, {
+			in: `22:00   日記   よく笑った`,
+			out: MemoLog{
+				LogItem: LogItem{
+					typ:       "日記",
+					content:   "",
+					notes:     "よく笑った",
+					createdAt: createdAt(22, 0),
+				},
+			},
+			str: `22:00 日記  よく笑った`,
+		}, {
+			in: `10:01 PM   Memo   `,
+			out: MemoLog{
+				LogItem: LogItem{
+					typ:       "Memo",
+					content:   "",
+					notes:     "",
+					createdAt: createdAt(22, 1),
+				},
+			},
+			str: `22:01 Memo`,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.in, func(t *testing.T) {
-			lg := NewLog(tt.in, date)
+			lg, err := NewLog(tt.in, date)
+			if err != nil {
+				t.Fatalf("unexpected error returned: %v", err)
+			}
 			if diff := cmp.Diff(tt.out, lg, cmpopts.EquateComparable(LogItem{})); diff != "" {
 				t.Errorf("log parse failure: %s", diff)
 			}
@@ -138,3 +591,77 @@ func Test_Log(t *testing.T) {
 		})
 	}
 }
+
+func Test_LogError(t *testing.T) {
+	date := time.Date(2023, time.December, 31, 0, 0, 0, 0, piyoLoc)
+	tests := []struct {
+		in  string
+		out Log
+		err error
+	}{
+		{
+			in:  `23:00`,
+			err: ErrInvalidLog,
+		}, {
+			in:  `23:00      たくさん飲んだ`,
+			err: ErrInvalidLog,
+		}, {
+			in: `08:45 AM   ミルク たくさん   `,
+			out: LogItem{
+				typ:       "ミルク",
+				content:   "たくさん",
+				createdAt: date.Add(time.Duration(8)*time.Hour + time.Duration(45)*time.Minute),
+			},
+			err: ErrInvalidContent,
+		}, {
+			in: `23:00   母乳 (ml)   `,
+			out: LogItem{
+				typ:       "母乳",
+				content:   "(ml)",
+				createdAt: date.Add(time.Duration(23) * time.Hour),
+			},
+			err: ErrInvalidContent,
+		}, {
+			in: `23:00   母乳 上 5分   `,
+			out: LogItem{
+				typ:       "母乳",
+				content:   "上 5分",
+				createdAt: date.Add(time.Duration(23) * time.Hour),
+			},
+			err: ErrInvalidContent,
+		}, {
+			in: `14:30   体温   `,
+			out: LogItem{
+				typ:       "体温",
+				createdAt: date.Add(time.Duration(14)*time.Hour + time.Duration(30)*time.Minute),
+			},
+			err: ErrInvalidContent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			lg, err := NewLog(tt.in, date)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("wrong error: want %v, got %v", tt.err, err)
+			}
+			if diff := cmp.Diff(tt.out, lg, cmpopts.EquateComparable(LogItem{})); diff != "" {
+				t.Errorf("log parse failure: %s", diff)
+			}
+		})
+	}
+}
+
+func FuzzNewLog(f *testing.F) {
+	date := time.Date(2023, time.December, 31, 0, 0, 0, 0, piyoLoc)
+	f.Add(`23:00   母乳 左 7分 / 右 5分 (50ml)   たくさん飲んだ`)
+	f.Add(`08:45 AM   ミルク 140ml   `)
+	f.Add(`02:55   起きる (3時間35分)   `)
+	f.Add(`14:30   体温 36.5°C   `)
+	f.Add(`23:00      `)
+	f.Fuzz(func(t *testing.T, in string) {
+		lg, err := NewLog(in, date)
+		if lg == nil && err == nil {
+			t.Errorf("either a log or an error must be returned: %q", in)
+		}
+	})
+}
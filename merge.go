@@ -0,0 +1,158 @@
+package piyolog
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// MergeReport describes what Merge merged into the first data.
+type MergeReport struct {
+	// EntriesAdded is the number of the entries only in the second data.
+	EntriesAdded int
+	// EntriesMerged is the number of the dates which both data have.
+	EntriesMerged int
+	// LogsAdded is the number of the logs of the second data added to the
+	// entries of the first data.
+	LogsAdded int
+	// LogsDuplicated is the number of the logs which both data have.
+	LogsDuplicated int
+	// Conflicts are the logs of the same time and kind with different contents.
+	Conflicts []Conflict
+}
+
+// Conflict represents the logs of the same time and kind whose contents or
+// notes differ between the data merged. The log of the first data is kept.
+type Conflict struct {
+	Date time.Time
+	A, B Log
+}
+
+func (c Conflict) String() string {
+	return fmt.Sprintf("%s: conflict: %q and %q",
+		c.Date.Format(time.DateOnly), c.A.String(), c.B.String())
+}
+
+// Merge returns the data of the entries of a and b by their dates.
+// See MergeWithReport for the details.
+func Merge(a, b *Data) (*Data, error) {
+	data, _, err := MergeWithReport(a, b)
+	return data, err
+}
+
+// MergeWithReport returns the data of the entries of a and b by their dates,
+// and the report of the merge.
+//
+// The logs of the entries of the same date are merged, dropping the logs of b
+// which have the same time, kind, content and notes as the ones of a. If the
+// logs of the same time and kind differ, the one of a is kept and the conflict
+// is reported. The baby and the results of a take precedence over the ones of b,
+// and the journals of both are kept.
+func MergeWithReport(a, b *Data) (*Data, *MergeReport, error) {
+	if a == nil || b == nil {
+		return nil, nil, errors.New("piyolog: merge nil data")
+	}
+	data := &Data{
+		Tag:    a.Tag,
+		Hour12: a.Hour12 || b.Hour12,
+	}
+	if data.Tag == language.Und {
+		data.Tag = b.Tag
+	}
+	report := &MergeReport{}
+	entries := map[string]int{}
+	for _, e := range a.Entries {
+		if i, ok := entries[dateKey(e.Date)]; ok {
+			// merge the entries of the same date in a as well.
+			data.Entries[i] = mergeEntry(data.Entries[i], e, &MergeReport{})
+			continue
+		}
+		entries[dateKey(e.Date)] = len(data.Entries)
+		data.Entries = append(data.Entries, e)
+	}
+	for _, e := range b.Entries {
+		i, ok := entries[dateKey(e.Date)]
+		if !ok {
+			report.EntriesAdded++
+			entries[dateKey(e.Date)] = len(data.Entries)
+			data.Entries = append(data.Entries, e)
+			continue
+		}
+		report.EntriesMerged++
+		data.Entries[i] = mergeEntry(data.Entries[i], e, report)
+	}
+	sort.SliceStable(data.Entries, func(i, j int) bool {
+		return data.Entries[i].Date.Before(data.Entries[j].Date)
+	})
+	data.Monthly = a.Monthly || b.Monthly || len(data.Entries) > 1
+	return data, report, nil
+}
+
+// dateKey returns the key of the calendar date of t. time.Time is not used as
+// a key since it includes the location, which differs between the data parsed
+// and the one decoded from JSON.
+func dateKey(t time.Time) string {
+	return t.Format(time.DateOnly)
+}
+
+// mergeEntry returns the entry of the logs of a and b.
+func mergeEntry(a, b Entry, report *MergeReport) Entry {
+	e := a
+	e.hour12 = a.hour12 || b.hour12
+	e.Logs = append([]Log(nil), a.Logs...)
+	matched := make([]bool, len(a.Logs))
+	for _, l := range b.Logs {
+		i := matchLog(a.Logs, matched, l)
+		if i < 0 {
+			report.LogsAdded++
+			e.Logs = append(e.Logs, l)
+			continue
+		}
+		matched[i] = true
+		if a.Logs[i].Content() == l.Content() && a.Logs[i].Notes() == l.Notes() {
+			report.LogsDuplicated++
+		} else {
+			report.Conflicts = append(report.Conflicts, Conflict{Date: e.Date, A: a.Logs[i], B: l})
+		}
+	}
+	sort.SliceStable(e.Logs, func(i, j int) bool {
+		return e.Logs[i].CreatedAt().Before(e.Logs[j].CreatedAt())
+	})
+	if e.Baby == nil {
+		e.Baby = b.Baby
+	}
+	if len(e.Results) == 0 {
+		e.Results, e.Summary = b.Results, b.Summary
+	}
+	switch {
+	case e.Journal == "":
+		e.Journal = b.Journal
+	case b.Journal != "" && b.Journal != e.Journal:
+		e.Journal = e.Journal + "\n" + b.Journal
+	}
+	return e
+}
+
+// matchLog returns the index of the log of the same time and kind as l which
+// is not matched yet, preferring the identical one. It returns -1 if not found.
+func matchLog(logs []Log, matched []bool, l Log) int {
+	found := -1
+	for i, v := range logs {
+		if matched[i] || !v.CreatedAt().Equal(l.CreatedAt()) || v.Kind() != l.Kind() {
+			continue
+		}
+		if v.Kind() == KindOther && v.Type() != l.Type() {
+			continue
+		}
+		if v.Content() == l.Content() && v.Notes() == l.Notes() {
+			return i
+		}
+		if found < 0 {
+			found = i
+		}
+	}
+	return found
+}
@@ -0,0 +1,134 @@
+package piyolog
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_Merge(t *testing.T) {
+	monthly, err := Parse(`【ぴよログ】2023年3月
+----------
+2023/3/7(火)
+ごふあ (0歳0か月20日)
+
+03:00   ミルク 90ml   
+06:00   おしっこ   
+
+ミルク合計　   1回 90ml
+おしっこ合計   1回
+
+----------
+2023/3/8(水)
+ごふあ (0歳0か月21日)
+
+03:00   ミルク 90ml   
+06:00   おしっこ   
+
+ミルク合計　   1回 90ml
+
+よく寝た
+----------
+`)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	daily, err := Parse(`【ぴよログ】2023/3/8(水)
+ごふあ (0歳0か月21日)
+
+03:00   ミルク 100ml   
+06:00   おしっこ   
+09:00   うんち   
+
+ミルク合計　   1回 100ml
+
+よく飲んだ
+`)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	other, err := Parse(`【ぴよログ】2023/3/9(木)
+
+03:00   ミルク 90ml   
+`)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+
+	data, report, err := MergeWithReport(monthly, daily)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	if data, err = Merge(data, other); err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	if !data.Monthly || len(data.Entries) != 3 {
+		t.Fatalf("unexpected data: %+v", data)
+	}
+
+	e := data.Entries[1]
+	var logs []string
+	for _, l := range e.Logs {
+		logs = append(logs, l.String())
+	}
+	want := []string{"03:00 ミルク 90ml", "06:00 おしっこ", "09:00 うんち"}
+	if diff := cmp.Diff(want, logs); diff != "" {
+		t.Errorf("Logs failure: %s", diff)
+	}
+	if e.Journal != "よく寝た\nよく飲んだ" {
+		t.Errorf("Journal = %q", e.Journal)
+	}
+
+	date := time.Date(2023, time.March, 8, 0, 0, 0, 0, piyoLoc)
+	if len(report.Conflicts) != 1 || !report.Conflicts[0].Date.Equal(date) ||
+		report.Conflicts[0].B.Content() != "100ml" {
+		t.Errorf("Conflicts = %v", report.Conflicts)
+	}
+	report.Conflicts = nil
+	wantReport := &MergeReport{
+		EntriesAdded:   0,
+		EntriesMerged:  1,
+		LogsAdded:      1,
+		LogsDuplicated: 1,
+	}
+	if diff := cmp.Diff(wantReport, report); diff != "" {
+		t.Errorf("MergeReport failure: %s", diff)
+	}
+
+	if _, err := Merge(nil, daily); err == nil {
+		t.Errorf("error must be returned for nil data")
+	}
+}
+
+func Test_MergeJSON(t *testing.T) {
+	data, err := Parse(`【ぴよログ】2023/12/31(日)
+
+03:00   ミルク 90ml   
+08:45   ミルク 140ml   
+`)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	b, err := json.Marshal(data)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	var decoded Data
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+
+	merged, report, err := MergeWithReport(data, &decoded)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	if len(merged.Entries) != 1 || len(merged.Entries[0].Logs) != 2 {
+		t.Errorf("unexpected data: %+v", merged)
+	}
+	want := &MergeReport{EntriesMerged: 1, LogsDuplicated: 2}
+	if diff := cmp.Diff(want, report); diff != "" {
+		t.Errorf("MergeReport failure: %s", diff)
+	}
+}
@@ -1,10 +1,7 @@
 package piyolog
 
 import (
-	"bufio"
-	"bytes"
 	"fmt"
-	"io"
 	"regexp"
 	"strconv"
 	"strings"
@@ -15,7 +12,7 @@ import (
 
 var piyoLoc, _ = time.LoadLocation("Asia/Tokyo")
 
-// SetLocation sets the location.
+// SetLocation sets the default location used when ParseOptions.Location is nil.
 func SetLocation(loc *time.Location) {
 	piyoLoc = loc
 }
@@ -51,6 +48,24 @@ func (s *section) end() {
 	*s = sectionEnd
 }
 
+func (s section) String() string {
+	switch s {
+	case sectionDate:
+		return "date"
+	case sectionBaby:
+		return "baby"
+	case sectionLogs:
+		return "logs"
+	case sectionResults:
+		return "results"
+	case sectionJournal:
+		return "journal"
+	case sectionEnd:
+		return "end"
+	}
+	return "head"
+}
+
 const (
 	piyologJa        = "【ぴよログ】"
 	piyologEn        = "[PiyoLog]"
@@ -58,48 +73,76 @@ const (
 )
 
 type Data struct {
-	Tag     language.Tag
-	Entries []Entry
+	Tag language.Tag `json:"language"`
+	// Monthly reports whether the data is exported as monthly data.
+	Monthly bool `json:"monthly"`
+	// Hour12 reports whether the times of logs are written in the 12-hour clock, such as "08:45 AM".
+	Hour12  bool    `json:"hour12"`
+	Entries []Entry `json:"entries"`
 }
 
 type Entry struct {
 	section section
-	Date    time.Time
-	Baby    *Baby
-	Logs    []Log
-	Results []string
-	Journal string
+	hour12  bool
+	Date    time.Time    `json:"date"`
+	Baby    *Baby        `json:"baby,omitempty"`
+	Logs    []Log        `json:"logs"`
+	Results []string     `json:"results"`
+	Summary DailySummary `json:"summary"`
+	Journal string       `json:"journal"`
 }
 
 type Baby struct {
-	Name        string
-	DateOfBirth time.Time
+	Name        string    `json:"name"`
+	DateOfBirth time.Time `json:"date_of_birth"`
+	// Sex is not included in export data, so it is SexUnknown unless it is set.
+	Sex Sex `json:"sex,omitempty"`
+}
+
+// AgeDays returns the days from the date of birth to the date of t,
+// ignoring the times of the day.
+func (b *Baby) AgeDays(t time.Time) int {
+	return int(civilDate(t).Sub(civilDate(b.DateOfBirth)).Hours() / 24)
+}
+
+// civilDate returns the date of t at midnight in UTC, so that the days
+// between the dates do not depend on their locations.
+func civilDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Sex represents the sex of a baby.
+type Sex int
+
+const (
+	SexUnknown Sex = iota
+	SexMale
+	SexFemale
+)
+
+func (s Sex) String() string {
+	switch s {
+	case SexMale:
+		return "male"
+	case SexFemale:
+		return "female"
+	}
+	return ""
 }
 
 func newData(str string) (d Data) {
-	switch {
-	case strings.Contains(str, piyologJa):
-		d.Tag = language.Japanese
-	case strings.Contains(str, piyologEn):
-		d.Tag = language.English
+	if l := detectLocale(str); l != nil {
+		d.Tag = l.Tag
 	}
 	return d
 }
 
-func (d Data) newEntry(str string) *Entry {
-	if str == "" {
+func (d Data) newEntry(str string, loc *time.Location) *Entry {
+	l := lookupLocale(d.Tag)
+	if str == "" || l == nil {
 		return nil
 	}
-	var layout string
-	switch d.Tag {
-	case language.Japanese:
-		str, _, _ = strings.Cut(str, "(")
-		layout = "2006/1/2"
-	case language.English:
-		_, str, _ = strings.Cut(str, ", ")
-		layout = "Jan 2, 2006"
-	}
-	date, err := time.ParseInLocation(layout, str, piyoLoc)
+	date, err := l.parseDate(str, loc)
 	if err != nil {
 		return nil
 	}
@@ -110,57 +153,77 @@ func (d Data) newEntry(str string) *Entry {
 	return e
 }
 
-var reBaby = regexp.MustCompile(`^(.*) \(([0-9]+)(歳|y)([0-9]+)(か月|m)([0-9]+)(日|d)\)$`)
-
 // newBaby returns au Baby value retrieving from the given value.
+// It returns nil if the value is not a baby information.
 func (e Entry) newBaby(str string) *Baby {
-	matches := reBaby.FindStringSubmatch(str)
-	y, _ := strconv.Atoi(matches[2])
-	m, _ := strconv.Atoi(matches[4])
-	d, _ := strconv.Atoi(matches[6])
-	return &Baby{
-		Name:        matches[1],
-		DateOfBirth: e.Date.AddDate(-y, -m, -d),
+	for _, l := range locales {
+		matches := l.reBaby.FindStringSubmatch(str)
+		if matches == nil {
+			continue
+		}
+		y, _ := strconv.Atoi(matches[2])
+		m, _ := strconv.Atoi(matches[3])
+		d, _ := strconv.Atoi(matches[4])
+		return &Baby{
+			Name:        matches[1],
+			DateOfBirth: e.Date.AddDate(-y, -m, -d),
+		}
 	}
+	return nil
 }
 
 var reLog = regexp.MustCompile(`^([0-9:]{5} ?(AM|PM)?)`)
 
-func (e *Entry) apply(line string) {
+func (e *Entry) apply(line string) error {
 	switch e.section {
 	case sectionDate:
 		e.section.next()
-		e.apply(line)
+		return e.apply(line)
 	case sectionBaby:
 		if line == "" {
-			return
+			return nil
 		}
-		if reBaby.MatchString(line) {
-			e.Baby = e.newBaby(line)
+		if baby := e.newBaby(line); baby != nil {
+			e.Baby = baby
 			e.section.next()
-			return
+			return nil
 		}
 		// if text doesn't contain a certain baby infomation, move to the next section.
 		e.section = sectionLogs
-		e.apply(line)
+		return e.apply(line)
 	case sectionLogs:
-		if line == "" && len(e.Logs) > 0 {
-			e.section.next()
-			return
+		if line == "" {
+			if len(e.Logs) > 0 {
+				e.section.next()
+			}
+			return nil
 		}
-		if reLog.MatchString(line) {
-			l := NewLog(line, e.Date)
-			if l != nil {
-				e.Logs = append(e.Logs, l)
+		sm := reLog.FindStringSubmatch(line)
+		if sm == nil {
+			// a day without logs may have only the totals or the journal.
+			if label, _, _ := strings.Cut(line, logSeparator); summaryKey(label) != "" {
+				e.section = sectionResults
+				return e.apply(line)
+			}
+			if len(e.Logs) == 0 {
+				e.section = sectionJournal
+				return e.apply(line)
 			}
-			return
+			return ErrUnknownLine
 		}
+		e.hour12 = e.hour12 || sm[2] != ""
+		l, err := NewLog(line, e.Date)
+		if l != nil {
+			e.Logs = append(e.Logs, l)
+		}
+		return err
 	case sectionResults:
 		if line == "" && len(e.Results) > 0 {
 			e.section = sectionJournal
-			return
+			return nil
 		}
 		e.Results = append(e.Results, line)
+		e.Summary.apply(line)
 	case sectionJournal:
 		if e.Journal == "" {
 			e.Journal = line
@@ -168,55 +231,11 @@ func (e *Entry) apply(line string) {
 			e.Journal = fmt.Sprintf("%s\n%s", e.Journal, line)
 		}
 	}
+	return nil
 }
 
 // Parse returns the Data value represented by the string.
 // It accepts only export data from PiyoLog. Any other value may return an error.
 func Parse(str string) (*Data, error) {
-	// replace escape line breaks with unescaped line breaks to be able to scan line by line.
-	str = strings.Replace(str, `\n`, "\n", -1)
-	// add one separator with TWO new lines to the tail of the file to handle the string as monthly data.
-	exportData := fmt.Sprintf("%s\n\n%s\n", str, piyologSeparator)
-	// replace "\n\n" (TWO new liens) with the separator with "\n" (one new line) with the separator
-	// in order not to parse the new line before the separator.
-	exportData = strings.ReplaceAll(
-		exportData, fmt.Sprintf("\n\n%s", piyologSeparator), fmt.Sprintf("\n%s", piyologSeparator))
-
-	scanner := bufio.NewScanner(bytes.NewBufferString(exportData))
-	// first, parse the head of the file to detect its language.
-	if !scanner.Scan() {
-		return nil, io.EOF
-	}
-	head := strings.TrimSpace(scanner.Text())
-	data := newData(head)
-	switch data.Tag {
-	case language.Japanese:
-		head = strings.TrimLeft(head, piyologJa)
-	case language.English:
-		head = strings.TrimLeft(head, piyologEn)
-	}
-
-	// generate an entry with the head text.
-	entry := data.newEntry(head)
-	for scanner.Scan() {
-		// handling the file as if monthly data.
-		line := scanner.Text()
-		if strings.HasPrefix(line, piyologSeparator) {
-			if entry != nil {
-				entry.section.end()
-				data.Entries = append(data.Entries, *entry)
-				entry = nil
-			}
-			continue
-		}
-		if entry == nil {
-			entry = data.newEntry(line)
-		} else {
-			entry.apply(line)
-		}
-	}
-	if err := scanner.Err(); err != nil {
-		return nil, err
-	}
-	return &data, nil
+	return ParseReader(strings.NewReader(str))
 }
@@ -77,7 +77,7 @@ func Test_newEntry(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.in, func(t *testing.T) {
-			entry := tt.data.newEntry(tt.in)
+			entry := tt.data.newEntry(tt.in, piyoLoc)
 			if diff := cmp.Diff(tt.out, entry, cmpopts.IgnoreUnexported(Entry{})); diff != "" {
 				t.Errorf("entry parse failure: %s", diff)
 			}
@@ -121,44 +121,42 @@ func Test_newBaby(t *testing.T) {
 	}
 }
 
-func Test_Parse(t *testing.T) {
-	tests := []struct {
-		in  string
-		out Data
-		err error
-	}{
-		{
-			in:  ``,
-			out: Data{},
-		},
-		{
-			in: `【ぴよログ】2023/12/31(水)
+var parseTests = []struct {
+	in  string
+	out Data
+	err error
+}{
+	{
+		in:  ``,
+		out: Data{},
+	},
+	{
+		in: `【ぴよログ】2023/12/31(水)
 
 08:45 AM   ミルク 140ml   たくさん飲んだ`,
-			out: Data{
-				Tag: language.Japanese,
-				Entries: []Entry{
-					Entry{
-						Date: time.Date(2023, time.December, 31, 0, 0, 0, 0, piyoLoc),
-						Baby: nil,
-						Logs: []Log{
-							FormulaLog{
-								LogItem: LogItem{
-									typ:       "ミルク",
-									content:   "140ml",
-									notes:     "たくさん飲んだ",
-									createdAt: time.Date(2023, time.December, 31, 8, 45, 0, 0, piyoLoc),
-								},
-								Amount: 140,
-								Unit:   "ml",
+		out: Data{
+			Tag: language.Japanese,
+			Entries: []Entry{
+				Entry{
+					Date: time.Date(2023, time.December, 31, 0, 0, 0, 0, piyoLoc),
+					Baby: nil,
+					Logs: []Log{
+						FormulaLog{
+							LogItem: LogItem{
+								typ:       "ミルク",
+								content:   "140ml",
+								notes:     "たくさん飲んだ",
+								createdAt: time.Date(2023, time.December, 31, 8, 45, 0, 0, piyoLoc),
 							},
+							Volume: Volume{Value: 140, Unit: Milliliter},
 						},
 					},
 				},
 			},
 		},
-		{
-			in: `【ぴよログ】2023/12/31(水)
+	},
+	{
+		in: `【ぴよログ】2023/12/31(水)
 ごふあ (0歳1か月1日)
 
 08:45 AM   ミルク 140ml   たくさん飲んだ
@@ -182,77 +180,82 @@ func Test_Parse(t *testing.T) {
 
 
 ここまで`,
-			out: Data{
-				Tag: language.Japanese,
-				Entries: []Entry{
-					Entry{
-						Date: time.Date(2023, time.December, 31, 0, 0, 0, 0, piyoLoc),
-						Baby: &Baby{
-							Name:        "ごふあ",
-							DateOfBirth: time.Date(2023, time.November, 30, 0, 0, 0, 0, piyoLoc),
-						},
-						Logs: []Log{
-							FormulaLog{
-								LogItem: LogItem{
-									typ:       "ミルク",
-									content:   "140ml",
-									notes:     "たくさん飲んだ",
-									createdAt: time.Date(2023, time.December, 31, 8, 45, 0, 0, piyoLoc),
-								},
-								Amount: 140,
-								Unit:   "ml",
-							},
-							SleepLog{
-								LogItem: LogItem{
-									typ:       "寝る",
-									content:   "",
-									createdAt: time.Date(2023, time.December, 31, 13, 55, 0, 0, piyoLoc),
-								},
+		out: Data{
+			Tag: language.Japanese,
+			Entries: []Entry{
+				Entry{
+					Date: time.Date(2023, time.December, 31, 0, 0, 0, 0, piyoLoc),
+					Baby: &Baby{
+						Name:        "ごふあ",
+						DateOfBirth: time.Date(2023, time.November, 30, 0, 0, 0, 0, piyoLoc),
+					},
+					Logs: []Log{
+						FormulaLog{
+							LogItem: LogItem{
+								typ:       "ミルク",
+								content:   "140ml",
+								notes:     "たくさん飲んだ",
+								createdAt: time.Date(2023, time.December, 31, 8, 45, 0, 0, piyoLoc),
 							},
-							WakeUpLog{
-								LogItem: LogItem{
-									typ:       "起きる",
-									content:   "(0時間50分)",
-									createdAt: time.Date(2023, time.December, 31, 14, 45, 0, 0, piyoLoc),
-								},
-								Duration: time.Duration(50) * time.Minute,
+							Volume: Volume{Value: 140, Unit: Milliliter},
+						},
+						SleepLog{
+							LogItem: LogItem{
+								typ:       "寝る",
+								content:   "",
+								createdAt: time.Date(2023, time.December, 31, 13, 55, 0, 0, piyoLoc),
 							},
-							BodyTemperatureLog{
-								LogItem: LogItem{
-									typ:       "体温",
-									content:   "36.4°C",
-									createdAt: time.Date(2023, time.December, 31, 15, 5, 0, 0, piyoLoc),
-								},
-								Temperature: 36.4,
-								Unit:        "°C",
+						},
+						WakeUpLog{
+							LogItem: LogItem{
+								typ:       "起きる",
+								content:   "(0時間50分)",
+								createdAt: time.Date(2023, time.December, 31, 14, 45, 0, 0, piyoLoc),
 							},
-							FormulaLog{
-								LogItem: LogItem{
-									typ:       "ミルク",
-									content:   "140ml",
-									createdAt: time.Date(2023, time.December, 31, 15, 50, 0, 0, piyoLoc),
-								},
-								Amount: 140,
-								Unit:   "ml",
+							Duration: time.Duration(50) * time.Minute,
+						},
+						BodyTemperatureLog{
+							LogItem: LogItem{
+								typ:       "体温",
+								content:   "36.4°C",
+								createdAt: time.Date(2023, time.December, 31, 15, 5, 0, 0, piyoLoc),
 							},
-							FormulaLog{
-								LogItem: LogItem{
-									typ:       "ミルク",
-									content:   "200ml",
-									createdAt: time.Date(2023, time.December, 31, 19, 35, 0, 0, piyoLoc),
-								},
-								Amount: 200,
-								Unit:   "ml",
+							Temperature: Temperature{Value: 36.4, Unit: Celsius},
+						},
+						FormulaLog{
+							LogItem: LogItem{
+								typ:       "ミルク",
+								content:   "140ml",
+								createdAt: time.Date(2023, time.December, 31, 15, 50, 0, 0, piyoLoc),
 							},
+							Volume: Volume{Value: 140, Unit: Milliliter},
 						},
-						Results: []string{
-							"母乳合計　　   左 7分 / 右 5分",
-							"ミルク合計　   7回 1140ml",
-							"睡眠合計　　   11時間50分",
-							"おしっこ合計   2回",
-							"うんち合計　   1回",
+						FormulaLog{
+							LogItem: LogItem{
+								typ:       "ミルク",
+								content:   "200ml",
+								createdAt: time.Date(2023, time.December, 31, 19, 35, 0, 0, piyoLoc),
+							},
+							Volume: Volume{Value: 200, Unit: Milliliter},
 						},
-						Journal: `お食い初めだよ
+					},
+					Results: []string{
+						"母乳合計　　   左 7分 / 右 5分",
+						"ミルク合計　   7回 1140ml",
+						"睡眠合計　　   11時間50分",
+						"おしっこ合計   2回",
+						"うんち合計　   1回",
+					},
+					Summary: DailySummary{
+						NursingLeft:   time.Duration(7) * time.Minute,
+						NursingRight:  time.Duration(5) * time.Minute,
+						FormulaCount:  7,
+						FormulaVolume: Volume{Value: 1140, Unit: Milliliter},
+						SleepTotal:    time.Duration(11)*time.Hour + time.Duration(50)*time.Minute,
+						PeeCount:      2,
+						PoopCount:     1,
+					},
+					Journal: `お食い初めだよ
 
 
 これは改行です
@@ -260,81 +263,77 @@ func Test_Parse(t *testing.T) {
 
 
 ここまで`,
-					},
 				},
 			},
 		},
-		{
-			in: `【ぴよログ】2023/12/31(水)\nごふあ (0歳1か月1日)\n\n\n08:45 AM   ミルク 140ml   たくさん飲んだ\n01:55 PM   寝る   \n02:45 PM   起きる (0時間50分)   \n03:05 PM   体温 36.4°C   \n03:50 PM   ミルク 140ml   \n07:35 PM   ミルク 200ml   `,
-			out: Data{
-				Tag: language.Japanese,
-				Entries: []Entry{
-					Entry{
-						Date: time.Date(2023, time.December, 31, 0, 0, 0, 0, piyoLoc),
-						Baby: &Baby{
-							Name:        "ごふあ",
-							DateOfBirth: time.Date(2023, time.November, 30, 0, 0, 0, 0, piyoLoc),
-						},
-						Logs: []Log{
-							FormulaLog{
-								LogItem: LogItem{
-									typ:       "ミルク",
-									content:   "140ml",
-									notes:     "たくさん飲んだ",
-									createdAt: time.Date(2023, time.December, 31, 8, 45, 0, 0, piyoLoc),
-								},
-								Amount: 140,
-								Unit:   "ml",
+	},
+	{
+		in: `【ぴよログ】2023/12/31(水)\nごふあ (0歳1か月1日)\n\n\n08:45 AM   ミルク 140ml   たくさん飲んだ\n01:55 PM   寝る   \n02:45 PM   起きる (0時間50分)   \n03:05 PM   体温 36.4°C   \n03:50 PM   ミルク 140ml   \n07:35 PM   ミルク 200ml   `,
+		out: Data{
+			Tag: language.Japanese,
+			Entries: []Entry{
+				Entry{
+					Date: time.Date(2023, time.December, 31, 0, 0, 0, 0, piyoLoc),
+					Baby: &Baby{
+						Name:        "ごふあ",
+						DateOfBirth: time.Date(2023, time.November, 30, 0, 0, 0, 0, piyoLoc),
+					},
+					Logs: []Log{
+						FormulaLog{
+							LogItem: LogItem{
+								typ:       "ミルク",
+								content:   "140ml",
+								notes:     "たくさん飲んだ",
+								createdAt: time.Date(2023, time.December, 31, 8, 45, 0, 0, piyoLoc),
 							},
-							SleepLog{
-								LogItem: LogItem{
-									typ:       "寝る",
-									content:   "",
-									createdAt: time.Date(2023, time.December, 31, 13, 55, 0, 0, piyoLoc),
-								},
+							Volume: Volume{Value: 140, Unit: Milliliter},
+						},
+						SleepLog{
+							LogItem: LogItem{
+								typ:       "寝る",
+								content:   "",
+								createdAt: time.Date(2023, time.December, 31, 13, 55, 0, 0, piyoLoc),
 							},
-							WakeUpLog{
-								LogItem: LogItem{
-									typ:       "起きる",
-									content:   "(0時間50分)",
-									createdAt: time.Date(2023, time.December, 31, 14, 45, 0, 0, piyoLoc),
-								},
-								Duration: time.Duration(50) * time.Minute,
+						},
+						WakeUpLog{
+							LogItem: LogItem{
+								typ:       "起きる",
+								content:   "(0時間50分)",
+								createdAt: time.Date(2023, time.December, 31, 14, 45, 0, 0, piyoLoc),
 							},
-							BodyTemperatureLog{
-								LogItem: LogItem{
-									typ:       "体温",
-									content:   "36.4°C",
-									createdAt: time.Date(2023, time.December, 31, 15, 5, 0, 0, piyoLoc),
-								},
-								Temperature: 36.4,
-								Unit:        "°C",
+							Duration: time.Duration(50) * time.Minute,
+						},
+						BodyTemperatureLog{
+							LogItem: LogItem{
+								typ:       "体温",
+								content:   "36.4°C",
+								createdAt: time.Date(2023, time.December, 31, 15, 5, 0, 0, piyoLoc),
 							},
-							FormulaLog{
-								LogItem: LogItem{
-									typ:       "ミルク",
-									content:   "140ml",
-									createdAt: time.Date(2023, time.December, 31, 15, 50, 0, 0, piyoLoc),
-								},
-								Amount: 140,
-								Unit:   "ml",
+							Temperature: Temperature{Value: 36.4, Unit: Celsius},
+						},
+						FormulaLog{
+							LogItem: LogItem{
+								typ:       "ミルク",
+								content:   "140ml",
+								createdAt: time.Date(2023, time.December, 31, 15, 50, 0, 0, piyoLoc),
 							},
-							FormulaLog{
-								LogItem: LogItem{
-									typ:       "ミルク",
-									content:   "200ml",
-									createdAt: time.Date(2023, time.December, 31, 19, 35, 0, 0, piyoLoc),
-								},
-								Amount: 200,
-								Unit:   "ml",
+							Volume: Volume{Value: 140, Unit: Milliliter},
+						},
+						FormulaLog{
+							LogItem: LogItem{
+								typ:       "ミルク",
+								content:   "200ml",
+								createdAt: time.Date(2023, time.December, 31, 19, 35, 0, 0, piyoLoc),
 							},
+							Volume: Volume{Value: 200, Unit: Milliliter},
 						},
 					},
 				},
 			},
 		},
-		{
-			in: `【ぴよログ】2024年8月
+	},
+	{
+		in: `【ぴよログ】2024年8月
 ----------
 2024/8/1(木)
 ごふあ (0歳2か月10日)
@@ -380,140 +379,204 @@ func Test_Parse(t *testing.T) {
 お食い初めだよ
 
 ----------`,
-			out: Data{
-				Tag: language.Japanese,
-				Entries: []Entry{
-					Entry{
-						Date: time.Date(2024, time.August, 1, 0, 0, 0, 0, piyoLoc),
-						Baby: &Baby{
-							Name:        "ごふあ",
-							DateOfBirth: time.Date(2024, time.May, 22, 0, 0, 0, 0, piyoLoc),
-						},
-						Logs: []Log{
-							WakeUpLog{
-								LogItem: LogItem{
-									typ:       "起きる",
-									content:   "(8時間40分)",
-									createdAt: time.Date(2024, time.August, 1, 4, 15, 0, 0, piyoLoc),
-								},
-								Duration: time.Duration(8)*time.Hour + time.Duration(40)*time.Minute,
-							},
-							FormulaLog{
-								LogItem: LogItem{
-									typ:       "ミルク",
-									content:   "110ml",
-									createdAt: time.Date(2024, time.August, 1, 4, 20, 0, 0, piyoLoc),
-								},
-								Amount: 110,
-								Unit:   "ml",
+		out: Data{
+			Tag: language.Japanese,
+			Entries: []Entry{
+				Entry{
+					Date: time.Date(2024, time.August, 1, 0, 0, 0, 0, piyoLoc),
+					Baby: &Baby{
+						Name:        "ごふあ",
+						DateOfBirth: time.Date(2024, time.May, 22, 0, 0, 0, 0, piyoLoc),
+					},
+					Logs: []Log{
+						WakeUpLog{
+							LogItem: LogItem{
+								typ:       "起きる",
+								content:   "(8時間40分)",
+								createdAt: time.Date(2024, time.August, 1, 4, 15, 0, 0, piyoLoc),
 							},
-							SleepLog{
-								LogItem: LogItem{
-									typ:       "寝る",
-									content:   "",
-									createdAt: time.Date(2024, time.August, 1, 20, 0, 0, 0, piyoLoc),
-								},
+							Duration: time.Duration(8)*time.Hour + time.Duration(40)*time.Minute,
+						},
+						FormulaLog{
+							LogItem: LogItem{
+								typ:       "ミルク",
+								content:   "110ml",
+								createdAt: time.Date(2024, time.August, 1, 4, 20, 0, 0, piyoLoc),
 							},
+							Volume: Volume{Value: 110, Unit: Milliliter},
 						},
-						Results: []string{
-							"母乳合計　　   左 0分 / 右 0分",
-							"ミルク合計　   7回 790ml",
-							"睡眠合計　　   12時間35分",
-							"おしっこ合計   3回",
-							"うんち合計　   1回",
+						SleepLog{
+							LogItem: LogItem{
+								typ:       "寝る",
+								content:   "",
+								createdAt: time.Date(2024, time.August, 1, 20, 0, 0, 0, piyoLoc),
+							},
 						},
 					},
-					Entry{
-						Date: time.Date(2024, time.August, 2, 0, 0, 0, 0, piyoLoc),
-						Baby: &Baby{
-							Name:        "ごふあ",
-							DateOfBirth: time.Date(2024, time.May, 22, 0, 0, 0, 0, piyoLoc),
-						},
-						Logs: []Log{
-							WakeUpLog{
-								LogItem: LogItem{
-									typ:       "起きる",
-									content:   "(8時間40分)",
-									createdAt: time.Date(2024, time.August, 2, 4, 15, 0, 0, piyoLoc),
-								},
-								Duration: time.Duration(8)*time.Hour + time.Duration(40)*time.Minute,
-							},
-							FormulaLog{
-								LogItem: LogItem{
-									typ:       "ミルク",
-									content:   "110ml",
-									createdAt: time.Date(2024, time.August, 2, 4, 20, 0, 0, piyoLoc),
-								},
-								Amount: 110,
-								Unit:   "ml",
+					Results: []string{
+						"母乳合計　　   左 0分 / 右 0分",
+						"ミルク合計　   7回 790ml",
+						"睡眠合計　　   12時間35分",
+						"おしっこ合計   3回",
+						"うんち合計　   1回",
+					},
+					Summary: DailySummary{
+						FormulaCount:  7,
+						FormulaVolume: Volume{Value: 790, Unit: Milliliter},
+						SleepTotal:    time.Duration(12)*time.Hour + time.Duration(35)*time.Minute,
+						PeeCount:      3,
+						PoopCount:     1,
+					},
+				},
+				Entry{
+					Date: time.Date(2024, time.August, 2, 0, 0, 0, 0, piyoLoc),
+					Baby: &Baby{
+						Name:        "ごふあ",
+						DateOfBirth: time.Date(2024, time.May, 22, 0, 0, 0, 0, piyoLoc),
+					},
+					Logs: []Log{
+						WakeUpLog{
+							LogItem: LogItem{
+								typ:       "起きる",
+								content:   "(8時間40分)",
+								createdAt: time.Date(2024, time.August, 2, 4, 15, 0, 0, piyoLoc),
 							},
-							SleepLog{
-								LogItem: LogItem{
-									typ:       "寝る",
-									content:   "",
-									createdAt: time.Date(2024, time.August, 2, 20, 0, 0, 0, piyoLoc),
-								},
+							Duration: time.Duration(8)*time.Hour + time.Duration(40)*time.Minute,
+						},
+						FormulaLog{
+							LogItem: LogItem{
+								typ:       "ミルク",
+								content:   "110ml",
+								createdAt: time.Date(2024, time.August, 2, 4, 20, 0, 0, piyoLoc),
 							},
+							Volume: Volume{Value: 110, Unit: Milliliter},
 						},
-						Results: []string{
-							"母乳合計　　   左 0分 / 右 0分",
-							"ミルク合計　   8回 750ml",
-							"睡眠合計　　   13時間50分",
-							"おしっこ合計   4回",
-							"うんち合計　   1回",
+						SleepLog{
+							LogItem: LogItem{
+								typ:       "寝る",
+								content:   "",
+								createdAt: time.Date(2024, time.August, 2, 20, 0, 0, 0, piyoLoc),
+							},
 						},
 					},
-					Entry{
-						Date: time.Date(2024, time.August, 4, 0, 0, 0, 0, piyoLoc),
-						Baby: &Baby{
-							Name:        "ごふあ",
-							DateOfBirth: time.Date(2024, time.May, 22, 0, 0, 0, 0, piyoLoc),
-						},
-						Logs: []Log{
-							WakeUpLog{
-								LogItem: LogItem{
-									typ:       "起きる",
-									content:   "(8時間40分)",
-									createdAt: time.Date(2024, time.August, 4, 4, 15, 0, 0, piyoLoc),
-								},
-								Duration: time.Duration(8)*time.Hour + time.Duration(40)*time.Minute,
-							},
-							FormulaLog{
-								LogItem: LogItem{
-									typ:       "ミルク",
-									content:   "110ml",
-									createdAt: time.Date(2024, time.August, 4, 4, 20, 0, 0, piyoLoc),
-								},
-								Amount: 110,
-								Unit:   "ml",
+					Results: []string{
+						"母乳合計　　   左 0分 / 右 0分",
+						"ミルク合計　   8回 750ml",
+						"睡眠合計　　   13時間50分",
+						"おしっこ合計   4回",
+						"うんち合計　   1回",
+					},
+					Summary: DailySummary{
+						FormulaCount:  8,
+						FormulaVolume: Volume{Value: 750, Unit: Milliliter},
+						SleepTotal:    time.Duration(13)*time.Hour + time.Duration(50)*time.Minute,
+						PeeCount:      4,
+						PoopCount:     1,
+					},
+				},
+				Entry{
+					Date: time.Date(2024, time.August, 4, 0, 0, 0, 0, piyoLoc),
+					Baby: &Baby{
+						Name:        "ごふあ",
+						DateOfBirth: time.Date(2024, time.May, 22, 0, 0, 0, 0, piyoLoc),
+					},
+					Logs: []Log{
+						WakeUpLog{
+							LogItem: LogItem{
+								typ:       "起きる",
+								content:   "(8時間40分)",
+								createdAt: time.Date(2024, time.August, 4, 4, 15, 0, 0, piyoLoc),
 							},
-							SleepLog{
-								LogItem: LogItem{
-									typ:       "寝る",
-									content:   "",
-									createdAt: time.Date(2024, time.August, 4, 20, 0, 0, 0, piyoLoc),
-								},
+							Duration: time.Duration(8)*time.Hour + time.Duration(40)*time.Minute,
+						},
+						FormulaLog{
+							LogItem: LogItem{
+								typ:       "ミルク",
+								content:   "110ml",
+								createdAt: time.Date(2024, time.August, 4, 4, 20, 0, 0, piyoLoc),
 							},
+							Volume: Volume{Value: 110, Unit: Milliliter},
 						},
-						Results: []string{
-							"母乳合計　　   左 0分 / 右 0分",
-							"ミルク合計　   7回 750ml",
-							"睡眠合計　　   14時間0分",
-							"おしっこ合計   2回",
-							"うんち合計　   0回",
+						SleepLog{
+							LogItem: LogItem{
+								typ:       "寝る",
+								content:   "",
+								createdAt: time.Date(2024, time.August, 4, 20, 0, 0, 0, piyoLoc),
+							},
 						},
-						Journal: `お食い初めだよ`,
 					},
+					Results: []string{
+						"母乳合計　　   左 0分 / 右 0分",
+						"ミルク合計　   7回 750ml",
+						"睡眠合計　　   14時間0分",
+						"おしっこ合計   2回",
+						"うんち合計　   0回",
+					},
+					Summary: DailySummary{
+						FormulaCount:  7,
+						FormulaVolume: Volume{Value: 750, Unit: Milliliter},
+						SleepTotal:    time.Duration(14) * time.Hour,
+						PeeCount:      2,
+					},
+					Journal: `お食い初めだよ`,
 				},
 			},
 		},
-		{
-			in:  `ごふあ (0歳1か月0日)`,
-			out: Data{},
+	},
+	{
+		in: `【ぴよログ】2024/8/1(木)
+ごふあ (0歳2か月10日)
+
+ミルク合計　   0回 0ml
+
+メモ`,
+		out: Data{
+			Tag: language.Japanese,
+			Entries: []Entry{
+				Entry{
+					Date: time.Date(2024, time.August, 1, 0, 0, 0, 0, piyoLoc),
+					Baby: &Baby{
+						Name:        "ごふあ",
+						DateOfBirth: time.Date(2024, time.May, 22, 0, 0, 0, 0, piyoLoc),
+					},
+					Results: []string{
+						"ミルク合計　   0回 0ml",
+					},
+					Summary: DailySummary{
+						FormulaVolume: Volume{Value: 0, Unit: Milliliter},
+					},
+					Journal: `メモ`,
+				},
+			},
 		},
-	}
+	},
+	{
+		in: `【ぴよログ】2024/8/1(木)
+ごふあ (0歳2か月10日)
 
-	for _, tt := range tests {
+メモ`,
+		out: Data{
+			Tag: language.Japanese,
+			Entries: []Entry{
+				Entry{
+					Date: time.Date(2024, time.August, 1, 0, 0, 0, 0, piyoLoc),
+					Baby: &Baby{
+						Name:        "ごふあ",
+						DateOfBirth: time.Date(2024, time.May, 22, 0, 0, 0, 0, piyoLoc),
+					},
+					Journal: `メモ`,
+				},
+			},
+		},
+	},
+	{
+		in:  `ごふあ (0歳1か月0日)`,
+		out: Data{},
+	},
+}
+
+func Test_Parse(t *testing.T) {
+	for _, tt := range parseTests {
 		t.Run(tt.in, func(t *testing.T) {
 			data, err := Parse(tt.in)
 			// error cases
@@ -541,3 +604,49 @@ func Test_Parse(t *testing.T) {
 		})
 	}
 }
+
+func FuzzParse(f *testing.F) {
+	f.Add(`【ぴよログ】2023/12/31(水)
+ごふあ (0歳1か月1日)
+
+08:45 AM   ミルク 140ml   たくさん飲んだ
+02:45 PM   起きる (0時間50分)   
+03:05 PM   体温 36.4°C   
+
+ミルク合計　   7回 1140ml
+
+お食い初めだよ`)
+	f.Add(`[PiyoLog]Thu, Jun 13, 2022\nGofua (0y1m1d)\n\n23:00   Nursing (50ml)   `)
+	f.Add(`【ぴよログ】2024年8月
+----------
+2024/8/1(木)
+ごふあ (0歳2か月10日)
+
+04:20 AM   ミルク   
+----------`)
+	f.Fuzz(func(t *testing.T, in string) {
+		data, _, err := ParseOptions{}.Parse(in)
+		if err == nil && data == nil {
+			t.Errorf("data must be returned: %q", in)
+		}
+		ParseOptions{Strict: true}.Parse(in)
+	})
+}
+
+func Test_AgeDays(t *testing.T) {
+	b := &Baby{DateOfBirth: time.Date(2024, time.February, 28, 0, 0, 0, 0, piyoLoc)}
+	tests := []struct {
+		in   time.Time
+		want int
+	}{
+		{in: time.Date(2024, time.February, 28, 23, 59, 0, 0, piyoLoc), want: 0},
+		{in: time.Date(2024, time.March, 1, 0, 1, 0, 0, piyoLoc), want: 2},
+		// the date in the other location is the date written in it.
+		{in: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), want: 2},
+	}
+	for _, tt := range tests {
+		if got := b.AgeDays(tt.in); got != tt.want {
+			t.Errorf("AgeDays(%v) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
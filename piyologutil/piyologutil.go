@@ -17,12 +17,16 @@ func ParseTime(str string) time.Time {
 	return t
 }
 
+var durationUnits = strings.NewReplacer(
+	"時間", "h", "小時", "h", "小时", "h", "시간", "h",
+	"分", "m", "분", "m",
+	"秒", "s", "초", "s",
+)
+
 // ParseDuration returns a time.Duration value interpreted by the given string,
-// such as "8時間15分", "7h40m" and "20m".
+// such as "8時間15分", "7h40m", "20m", "5分30秒", "1小時5分" and "1시간5분".
 func ParseDuration(str string) time.Duration {
-	str = strings.Replace(str, "時間", "h", 1)
-	str = strings.Replace(str, "分", "m", 1)
-	d, err := time.ParseDuration(str)
+	d, err := time.ParseDuration(durationUnits.Replace(str))
 	if err != nil {
 		return 0
 	}
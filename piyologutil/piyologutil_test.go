@@ -40,6 +40,8 @@ func Test_ParseDuration(t *testing.T) {
 		{"11h30m", time.Duration(11)*time.Hour + time.Duration(30)*time.Minute},
 		{"10時間25分", time.Duration(10)*time.Hour + time.Duration(25)*time.Minute},
 		{"21時間45分", time.Duration(21)*time.Hour + time.Duration(45)*time.Minute},
+		{"5分30秒", time.Duration(5)*time.Minute + time.Duration(30)*time.Second},
+		{"7m30s", time.Duration(7)*time.Minute + time.Duration(30)*time.Second},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,185 @@
+// Package predict estimates the next feeding and sleep of the baby from the
+// recent logs of PiyoLog data.
+//
+// The estimate is the weighted mean of the recent intervals added to the last
+// feeding or wake-up. The weight of an interval decays exponentially with its
+// age, and is larger for the intervals which started around the same time of
+// the day as the last one.
+package predict
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/kaneshin/piyolog"
+)
+
+var (
+	// ErrInsufficientData is returned when the data has too few intervals.
+	ErrInsufficientData = errors.New("predict: insufficient data")
+	// ErrAsleep is returned by NextSleep when the baby has not woken up yet.
+	ErrAsleep = errors.New("predict: asleep")
+)
+
+// minSamples is the least number of the intervals to estimate.
+const minSamples = 2
+
+// Options holds the options of the estimates.
+// The zero value is the default of every option.
+type Options struct {
+	// Window is how far back from the last feeding or wake-up the intervals
+	// are used. It defaults to 7 days.
+	Window time.Duration
+	// HalfLife is the age at which the weight of an interval halves.
+	// It defaults to 24 hours.
+	HalfLife time.Duration
+	// TimeOfDay is the standard deviation of the difference of the times of
+	// the day for the weights. It defaults to 3 hours, and a negative value
+	// ignores the time of the day.
+	TimeOfDay time.Duration
+	// SessionGap is the gap of piyolog.Feedings.
+	SessionGap time.Duration
+}
+
+func (o Options) withDefaults() Options {
+	if o.Window <= 0 {
+		o.Window = 7 * 24 * time.Hour
+	}
+	if o.HalfLife <= 0 {
+		o.HalfLife = 24 * time.Hour
+	}
+	if o.TimeOfDay == 0 {
+		o.TimeOfDay = 3 * time.Hour
+	}
+	return o
+}
+
+// Prediction represents an estimated time with its confidence range, which is
+// one weighted standard deviation of the intervals around the estimate.
+type Prediction struct {
+	// Last is the time of the last feeding or wake-up.
+	Last time.Time
+	// At is the estimated time.
+	At time.Time
+	// Earliest and Latest are the range of the estimate.
+	Earliest, Latest time.Time
+	// Interval is the estimated interval from Last.
+	Interval time.Duration
+	// Samples is the number of the intervals used.
+	Samples int
+}
+
+func (p Prediction) String() string {
+	return fmt.Sprintf("%s (%s-%s)",
+		p.At.Format("15:04"), p.Earliest.Format("15:04"), p.Latest.Format("15:04"))
+}
+
+// sample is an interval which started at the time.
+type sample struct {
+	start    time.Time
+	interval time.Duration
+}
+
+// NextFeed returns the estimated time of the next feeding from the intervals
+// between the starts of the feedings.
+func NextFeed(data *piyolog.Data, opts Options) (Prediction, error) {
+	opts = opts.withDefaults()
+	feedings := piyolog.Feedings(data, opts.SessionGap)
+	if len(feedings) == 0 {
+		return Prediction{}, ErrInsufficientData
+	}
+	var samples []sample
+	for i := 1; i < len(feedings); i++ {
+		samples = append(samples, sample{feedings[i-1].Start, feedings[i].Start.Sub(feedings[i-1].Start)})
+	}
+	return estimate(feedings[len(feedings)-1].Start, samples, opts)
+}
+
+// NextSleep returns the estimated time of the next sleep from the times awake
+// between the sleeps. It returns ErrAsleep if the last sleep has not ended.
+func NextSleep(data *piyolog.Data, opts Options) (Prediction, error) {
+	opts = opts.withDefaults()
+	sessions := piyolog.Sleeps(data)
+	if len(sessions) == 0 {
+		return Prediction{}, ErrInsufficientData
+	}
+	last := sessions[len(sessions)-1]
+	if last.End.IsZero() {
+		return Prediction{}, ErrAsleep
+	}
+	var samples []sample
+	for i := 1; i < len(sessions); i++ {
+		woke, slept := sessions[i-1].End, sessions[i].Start
+		if woke.IsZero() || slept.IsZero() || !slept.After(woke) {
+			continue
+		}
+		samples = append(samples, sample{woke, slept.Sub(woke)})
+	}
+	return estimate(last.End, samples, opts)
+}
+
+// estimate returns the prediction of the interval from last by the samples.
+func estimate(last time.Time, samples []sample, opts Options) (Prediction, error) {
+	var sum, weights float64
+	var used []sample
+	var ws []float64
+	for _, s := range samples {
+		age := last.Sub(s.start)
+		if age < 0 || age > opts.Window {
+			continue
+		}
+		w := math.Pow(0.5, float64(age)/float64(opts.HalfLife))
+		if opts.TimeOfDay > 0 {
+			d := timeOfDayDiff(s.start, last)
+			sd := float64(opts.TimeOfDay)
+			// keep a floor so that the other times of the day still count.
+			w *= 0.1 + math.Exp(-float64(d)*float64(d)/(2*sd*sd))
+		}
+		sum += w * float64(s.interval)
+		weights += w
+		used = append(used, s)
+		ws = append(ws, w)
+	}
+	if len(used) < minSamples {
+		return Prediction{}, ErrInsufficientData
+	}
+	mean := sum / weights
+	var variance float64
+	for i, s := range used {
+		d := float64(s.interval) - mean
+		variance += ws[i] * d * d
+	}
+	sd := time.Duration(math.Sqrt(variance / weights))
+	interval := time.Duration(mean).Round(time.Minute)
+	p := Prediction{
+		Last:     last,
+		At:       last.Add(interval),
+		Earliest: last.Add(interval - sd).Round(time.Minute),
+		Latest:   last.Add(interval + sd).Round(time.Minute),
+		Interval: interval,
+		Samples:  len(used),
+	}
+	if p.Earliest.Before(last) {
+		p.Earliest = last
+	}
+	return p, nil
+}
+
+// timeOfDayDiff returns the difference of the times of the day of a and b,
+// which is at most 12 hours.
+func timeOfDayDiff(a, b time.Time) time.Duration {
+	b = b.In(a.Location())
+	clock := func(t time.Time) time.Duration {
+		return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	}
+	d := clock(a) - clock(b)
+	if d < 0 {
+		d = -d
+	}
+	if d > 12*time.Hour {
+		d = 24*time.Hour - d
+	}
+	return d
+}
@@ -0,0 +1,96 @@
+package predict
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kaneshin/piyolog"
+)
+
+func parse(t *testing.T, s string) *piyolog.Data {
+	t.Helper()
+	data, err := piyolog.Parse(s)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	return data
+}
+
+func Test_NextFeed(t *testing.T) {
+	data := parse(t, `【ぴよログ】2023/3/8(水)
+
+00:00   ミルク 90ml   
+03:00   ミルク 90ml   
+06:00   母乳 左 10分   
+06:10   ミルク 60ml   
+09:00   ミルク 90ml   
+`)
+	p, err := NextFeed(data, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	if got := p.String(); got != "12:00 (12:00-12:00)" || p.Samples != 3 {
+		t.Errorf("NextFeed = %s of %d samples", got, p.Samples)
+	}
+
+	data = parse(t, `【ぴよログ】2023/3/8(水)
+
+00:00   ミルク 90ml   
+03:00   ミルク 90ml   
+07:00   ミルク 90ml   
+10:00   ミルク 90ml   
+`)
+	p, err = NextFeed(data, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	if p.Interval <= 3*time.Hour || p.Interval >= 4*time.Hour ||
+		!p.Earliest.Before(p.At) || !p.Latest.After(p.At) {
+		t.Errorf("NextFeed = %+v", p)
+	}
+
+	data = parse(t, "【ぴよログ】2023/3/8(水)\n\n00:00   ミルク 90ml   \n")
+	if _, err := NextFeed(data, Options{}); !errors.Is(err, ErrInsufficientData) {
+		t.Errorf("ErrInsufficientData must be returned: %v", err)
+	}
+}
+
+func Test_NextSleep(t *testing.T) {
+	data := parse(t, `【ぴよログ】2023/3/8(水)
+
+01:00   寝る   
+03:00   起きる (2時間0分)   
+04:30   寝る   
+06:00   起きる (1時間30分)   
+07:30   寝る   
+09:00   起きる (1時間30分)   
+`)
+	p, err := NextSleep(data, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	loc := p.Last.Location()
+	want := Prediction{
+		Last:     time.Date(2023, time.March, 8, 9, 0, 0, 0, loc),
+		At:       time.Date(2023, time.March, 8, 10, 30, 0, 0, loc),
+		Earliest: time.Date(2023, time.March, 8, 10, 30, 0, 0, loc),
+		Latest:   time.Date(2023, time.March, 8, 10, 30, 0, 0, loc),
+		Interval: 90 * time.Minute,
+		Samples:  2,
+	}
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("NextSleep failure: %s", diff)
+	}
+
+	data = parse(t, `【ぴよログ】2023/3/8(水)
+
+01:00   寝る   
+03:00   起きる (2時間0分)   
+04:30   寝る   
+`)
+	if _, err := NextSleep(data, Options{}); !errors.Is(err, ErrAsleep) {
+		t.Errorf("ErrAsleep must be returned: %v", err)
+	}
+}
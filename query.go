@@ -0,0 +1,85 @@
+package piyolog
+
+import (
+	"iter"
+	"slices"
+	"time"
+)
+
+// Filter reports whether the log of the entry is selected.
+type Filter func(e *Entry, l Log) bool
+
+// Between returns the filter of the logs created at or after from and before
+// to. A zero from or to leaves the range unbounded.
+func Between(from, to time.Time) Filter {
+	return func(_ *Entry, l Log) bool {
+		t := l.CreatedAt()
+		return (from.IsZero() || !t.Before(from)) && (to.IsZero() || t.Before(to))
+	}
+}
+
+// OfKind returns the filter of the logs of any of the kinds.
+func OfKind(kinds ...Kind) Filter {
+	return func(_ *Entry, l Log) bool {
+		return slices.Contains(kinds, l.Kind())
+	}
+}
+
+// WithNotes returns the filter of the logs which have notes.
+func WithNotes() Filter {
+	return func(_ *Entry, l Log) bool {
+		return l.Notes() != ""
+	}
+}
+
+// Not returns the filter of the logs which f does not select.
+func Not(f Filter) Filter {
+	return func(e *Entry, l Log) bool {
+		return !f(e, l)
+	}
+}
+
+// Any returns the filter of the logs which any of the filters selects.
+func Any(filters ...Filter) Filter {
+	return func(e *Entry, l Log) bool {
+		for _, f := range filters {
+			if f(e, l) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Logs returns the iterator over the logs of the entries with the entries,
+// which yields the logs selected by all of the filters.
+func (d *Data) Logs(filters ...Filter) iter.Seq2[*Entry, Log] {
+	return func(yield func(*Entry, Log) bool) {
+		for i := range d.Entries {
+			e := &d.Entries[i]
+		logs:
+			for _, l := range e.Logs {
+				for _, f := range filters {
+					if !f(e, l) {
+						continue logs
+					}
+				}
+				if !yield(e, l) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// LogsOf returns the iterator over the logs of the type T selected by all of
+// the filters, such as LogsOf[FormulaLog](data).
+func LogsOf[T Log](d *Data, filters ...Filter) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, l := range d.Logs(filters...) {
+			if v, ok := l.(T); ok && !yield(v) {
+				return
+			}
+		}
+	}
+}
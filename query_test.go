@@ -0,0 +1,106 @@
+package piyolog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_Logs(t *testing.T) {
+	data, err := Parse(`【ぴよログ】2023年3月
+----------
+2023/3/7(火)
+
+03:00   ミルク 90ml   
+06:00   おしっこ   
+07:00   うんち   ゆるい
+----------
+2023/3/8(水)
+
+03:00   ミルク 100ml   ぐずった
+06:00   母乳 左 10分   
+09:00   うんち   
+----------
+`)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	date := func(day, hour int) time.Time {
+		return time.Date(2023, time.March, day, hour, 0, 0, 0, piyoLoc)
+	}
+	format := func(seq func(func(*Entry, Log) bool)) []string {
+		var s []string
+		for e, l := range seq {
+			s = append(s, e.Date.Format(time.DateOnly)+" "+l.String())
+		}
+		return s
+	}
+
+	tests := []struct {
+		name    string
+		filters []Filter
+		want    []string
+	}{
+		{
+			name: "all",
+			want: []string{
+				"2023-03-07 03:00 ミルク 90ml",
+				"2023-03-07 06:00 おしっこ",
+				"2023-03-07 07:00 うんち  ゆるい",
+				"2023-03-08 03:00 ミルク 100ml ぐずった",
+				"2023-03-08 06:00 母乳 左 10分",
+				"2023-03-08 09:00 うんち",
+			},
+		},
+		{
+			name:    "between",
+			filters: []Filter{Between(date(7, 6), date(8, 6))},
+			want: []string{
+				"2023-03-07 06:00 おしっこ",
+				"2023-03-07 07:00 うんち  ゆるい",
+				"2023-03-08 03:00 ミルク 100ml ぐずった",
+			},
+		},
+		{
+			name:    "kind with notes",
+			filters: []Filter{OfKind(KindFormula, KindPoop), WithNotes()},
+			want: []string{
+				"2023-03-07 07:00 うんち  ゆるい",
+				"2023-03-08 03:00 ミルク 100ml ぐずった",
+			},
+		},
+		{
+			name:    "not any",
+			filters: []Filter{Not(Any(OfKind(KindFormula), Between(time.Time{}, date(8, 0))))},
+			want: []string{
+				"2023-03-08 06:00 母乳 左 10分",
+				"2023-03-08 09:00 うんち",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, format(data.Logs(tt.filters...))); diff != "" {
+				t.Errorf("Logs failure: %s", diff)
+			}
+		})
+	}
+
+	var volumes []float64
+	for l := range LogsOf[FormulaLog](data, Between(date(8, 0), time.Time{})) {
+		volumes = append(volumes, l.Volume.Value)
+	}
+	if diff := cmp.Diff([]float64{100}, volumes); diff != "" {
+		t.Errorf("LogsOf failure: %s", diff)
+	}
+
+	n := 0
+	for range data.Logs() {
+		n++
+		break
+	}
+	if n != 1 {
+		t.Errorf("Logs must stop when the loop breaks")
+	}
+}
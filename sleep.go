@@ -0,0 +1,82 @@
+package piyolog
+
+import "time"
+
+// SleepSession represents a sleep from a SleepLog to the following WakeUpLog.
+type SleepSession struct {
+	Start time.Time
+	// End is zero if the baby has not woken up in the data.
+	End time.Time
+	// Sleep is nil if the session has no SleepLog, which is an orphan wake-up.
+	Sleep *SleepLog
+	// WakeUp is nil if the baby has not woken up in the data.
+	WakeUp *WakeUpLog
+	// Inferred reports whether Start is inferred from the duration of the WakeUpLog.
+	// Start is zero if the session has neither a SleepLog nor the duration.
+	Inferred bool
+	// Mismatch reports whether the duration of the WakeUpLog differs from the time
+	// between the SleepLog and the WakeUpLog.
+	Mismatch bool
+	// Overlap reports whether the session starts before the previous session ends.
+	Overlap bool
+}
+
+// Duration returns the duration of the session.
+// It returns 0 if either Start or End is unknown.
+func (s SleepSession) Duration() time.Duration {
+	if s.Start.IsZero() || s.End.IsZero() {
+		return 0
+	}
+	return s.End.Sub(s.Start)
+}
+
+// Sleeps returns the sleep sessions in the data, pairing every SleepLog with
+// the following WakeUpLog even if it is in the next entry.
+func Sleeps(data *Data) []SleepSession {
+	var (
+		sessions []SleepSession
+		open     *SleepSession
+	)
+	for _, e := range data.Entries {
+		for _, l := range e.Logs {
+			switch v := l.(type) {
+			case SleepLog:
+				if open != nil {
+					sessions = append(sessions, *open)
+				}
+				open = &SleepSession{
+					Start: v.CreatedAt(),
+					Sleep: &v,
+				}
+			case WakeUpLog:
+				s := SleepSession{
+					End:    v.CreatedAt(),
+					WakeUp: &v,
+				}
+				if open != nil {
+					s.Start = open.Start
+					s.Sleep = open.Sleep
+					s.Mismatch = v.Duration > 0 && v.Duration != s.Duration()
+					open = nil
+				} else if v.Duration > 0 {
+					s.Start = v.CreatedAt().Add(-v.Duration)
+					s.Inferred = true
+				}
+				sessions = append(sessions, s)
+			}
+		}
+	}
+	if open != nil {
+		sessions = append(sessions, *open)
+	}
+
+	var end time.Time
+	for i := range sessions {
+		s := &sessions[i]
+		s.Overlap = !s.Start.IsZero() && s.Start.Before(end)
+		if s.End.After(end) {
+			end = s.End
+		}
+	}
+	return sessions
+}
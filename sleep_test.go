@@ -0,0 +1,86 @@
+package piyolog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_Sleeps(t *testing.T) {
+	in := `【ぴよログ】2024年8月
+----------
+2024/8/1(木)
+
+04:15 AM   起きる (8時間40分)   
+09:00 AM   寝る   
+10:00 AM   起きる (1時間0分)   
+01:00 PM   寝る   
+02:00 PM   起きる (0時間50分)   
+02:30 PM   寝る   
+08:00 PM   寝る   
+
+----------
+2024/8/2(金)
+
+04:15 AM   起きる (8時間15分)   
+04:30 AM   起きる   
+11:00 PM   寝る   
+
+----------`
+	data, err := Parse(in)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	at := func(d, h, m int) time.Time {
+		return time.Date(2024, time.August, d, h, m, 0, 0, piyoLoc)
+	}
+	type session struct {
+		Start, End                  time.Time
+		Sleep, WakeUp               bool
+		Inferred, Mismatch, Overlap bool
+		Duration                    time.Duration
+	}
+	want := []session{
+		{Start: at(0, 19, 35), End: at(1, 4, 15), WakeUp: true, Inferred: true, Duration: 8*time.Hour + 40*time.Minute},
+		{Start: at(1, 9, 0), End: at(1, 10, 0), Sleep: true, WakeUp: true, Duration: time.Hour},
+		{Start: at(1, 13, 0), End: at(1, 14, 0), Sleep: true, WakeUp: true, Mismatch: true, Duration: time.Hour},
+		{Start: at(1, 14, 30), Sleep: true},
+		{Start: at(1, 20, 0), End: at(2, 4, 15), Sleep: true, WakeUp: true, Duration: 8*time.Hour + 15*time.Minute},
+		{End: at(2, 4, 30), WakeUp: true},
+		{Start: at(2, 23, 0), Sleep: true},
+	}
+	var got []session
+	for _, s := range Sleeps(data) {
+		got = append(got, session{
+			Start:    s.Start,
+			End:      s.End,
+			Sleep:    s.Sleep != nil,
+			WakeUp:   s.WakeUp != nil,
+			Inferred: s.Inferred,
+			Mismatch: s.Mismatch,
+			Overlap:  s.Overlap,
+			Duration: s.Duration(),
+		})
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("%s", diff)
+	}
+}
+
+func Test_SleepsOverlap(t *testing.T) {
+	in := `【ぴよログ】2024/8/1(木)
+
+09:00 AM   寝る   
+10:00 AM   起きる (1時間0分)   
+10:30 AM   起きる (1時間0分)   
+`
+	data, err := Parse(in)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	sessions := Sleeps(data)
+	if len(sessions) != 2 || sessions[0].Overlap || !sessions[1].Overlap || !sessions[1].Inferred {
+		t.Errorf("the second session must overlap: %+v", sessions)
+	}
+}
@@ -0,0 +1,282 @@
+// Package stats computes the feeding statistics of PiyoLog data.
+//
+// The feeds are the formula and nursing logs. The volumes are summed in one
+// unit, converting the ones of the other units.
+package stats
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/kaneshin/piyolog"
+)
+
+// Options holds the options of Feeding.
+// The zero value is the default of every option.
+type Options struct {
+	// From and To are the first and the last dates of the window.
+	// The window is unbounded if they are zero.
+	From, To time.Time
+	// Unit is the unit of the volumes. It defaults to piyolog.Milliliter.
+	Unit piyolog.VolumeUnit
+	// RollingDays is the number of the days of the rolling totals.
+	// It defaults to 7.
+	RollingDays int
+	// NightStart and NightEnd are the hours when the night starts and ends.
+	// They default to 19 and 7.
+	NightStart, NightEnd int
+	// SessionGap is the gap of piyolog.Feedings.
+	SessionGap time.Duration
+}
+
+func (o Options) withDefaults() Options {
+	if o.Unit == piyolog.VolumeUnitUnknown {
+		o.Unit = piyolog.Milliliter
+	}
+	if o.RollingDays <= 0 {
+		o.RollingDays = 7
+	}
+	if o.NightStart == 0 && o.NightEnd == 0 {
+		o.NightStart, o.NightEnd = 19, 7
+	}
+	return o
+}
+
+// inWindow reports whether the entry is in the window.
+func (o Options) inWindow(e *piyolog.Entry) bool {
+	return (o.From.IsZero() || !e.Date.Before(o.From)) && (o.To.IsZero() || !e.Date.After(o.To))
+}
+
+// isNight reports whether t is in the night.
+func (o Options) isNight(t time.Time) bool {
+	h := t.Hour()
+	if o.NightStart <= o.NightEnd {
+		return o.NightStart <= h && h < o.NightEnd
+	}
+	return o.NightStart <= h || h < o.NightEnd
+}
+
+// Day holds the feeding totals of a day, or of the days of a rolling window.
+type Day struct {
+	Date            time.Time
+	Feeds           int
+	NightFeeds      int
+	FormulaCount    int
+	FormulaVolume   piyolog.Volume
+	NursingCount    int
+	NursingDuration time.Duration
+	// NursingVolume is the total of the nursing logs which have the volume.
+	NursingVolume piyolog.Volume
+}
+
+func (d *Day) add(v Day) {
+	d.Feeds += v.Feeds
+	d.NightFeeds += v.NightFeeds
+	d.FormulaCount += v.FormulaCount
+	d.FormulaVolume = d.FormulaVolume.Add(v.FormulaVolume)
+	d.NursingCount += v.NursingCount
+	d.NursingDuration += v.NursingDuration
+	d.NursingVolume = d.NursingVolume.Add(v.NursingVolume)
+}
+
+// Gap represents the time between two feeds.
+type Gap struct {
+	From, To time.Time
+}
+
+// Duration returns the duration of the gap.
+func (g Gap) Duration() time.Duration {
+	return g.To.Sub(g.From)
+}
+
+// Report holds the feeding statistics of the window.
+type Report struct {
+	// Days are the totals of the dates of the entries in the window.
+	Days []Day
+	// Rolling are the totals of the RollingDays days up to every date of Days.
+	Rolling []Day
+	// Total is the totals of all days in the window. Its Date is zero.
+	Total Day
+	// DayFeeds is the number of the feeds out of the night.
+	DayFeeds int
+	// MeanInterval and MedianInterval are the mean and median of the time
+	// between the starts of the feedings.
+	MeanInterval   time.Duration
+	MedianInterval time.Duration
+	// LongestGap is the longest time between the feedings.
+	LongestGap Gap
+	// Volumes is the distribution of the volumes of the feeds.
+	Volumes Distribution
+}
+
+// Feeding returns the feeding statistics of the entries of data in the window.
+func Feeding(data *piyolog.Data, opts Options) *Report {
+	opts = opts.withDefaults()
+	r := &Report{
+		Volumes: Distribution{Unit: opts.Unit},
+	}
+	for _, e := range data.Entries {
+		if !opts.inWindow(&e) {
+			continue
+		}
+		day := Day{Date: e.Date}
+		for _, l := range e.Logs {
+			var volume piyolog.Volume
+			switch v := l.(type) {
+			case piyolog.FormulaLog:
+				day.FormulaCount++
+				volume = v.Volume.In(opts.Unit)
+				day.FormulaVolume = day.FormulaVolume.Add(volume)
+			case piyolog.NursingLog:
+				day.NursingCount++
+				day.NursingDuration += v.Left + v.Right
+				volume = v.Volume.In(opts.Unit)
+				day.NursingVolume = day.NursingVolume.Add(volume)
+			default:
+				continue
+			}
+			day.Feeds++
+			if opts.isNight(l.CreatedAt()) {
+				day.NightFeeds++
+			}
+			if volume.Unit != piyolog.VolumeUnitUnknown {
+				r.Volumes.Values = append(r.Volumes.Values, volume.Value)
+			}
+		}
+		r.Days = append(r.Days, day)
+		r.Total.add(day)
+	}
+	sort.Slice(r.Days, func(i, j int) bool {
+		return r.Days[i].Date.Before(r.Days[j].Date)
+	})
+	r.Rolling = rolling(r.Days, opts.RollingDays)
+	r.DayFeeds = r.Total.Feeds - r.Total.NightFeeds
+	sort.Float64s(r.Volumes.Values)
+
+	feedings := piyolog.Feedings(data, opts.SessionGap, func(e *piyolog.Entry, _ piyolog.Log) bool {
+		return opts.inWindow(e)
+	})
+	var intervals []time.Duration
+	for i := 1; i < len(feedings); i++ {
+		intervals = append(intervals, feedings[i].Start.Sub(feedings[i-1].Start))
+		gap := Gap{From: feedings[i-1].End, To: feedings[i].Start}
+		if gap.Duration() > r.LongestGap.Duration() {
+			r.LongestGap = gap
+		}
+	}
+	r.MeanInterval, r.MedianInterval = meanAndMedian(intervals)
+	return r
+}
+
+// rolling returns the totals of the n days up to every date of days.
+func rolling(days []Day, n int) []Day {
+	var totals []Day
+	for i, d := range days {
+		total := Day{Date: d.Date}
+		from := d.Date.AddDate(0, 0, -n)
+		for j := i; j >= 0 && days[j].Date.After(from); j-- {
+			total.add(days[j])
+		}
+		totals = append(totals, total)
+	}
+	return totals
+}
+
+func meanAndMedian(ds []time.Duration) (mean, median time.Duration) {
+	if len(ds) == 0 {
+		return 0, 0
+	}
+	sorted := append([]time.Duration(nil), ds...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+	var sum time.Duration
+	for _, d := range sorted {
+		sum += d
+	}
+	mean = sum / time.Duration(len(sorted))
+	if n := len(sorted); n%2 == 1 {
+		median = sorted[n/2]
+	} else {
+		median = (sorted[n/2-1] + sorted[n/2]) / 2
+	}
+	return mean, median
+}
+
+// Distribution represents the distribution of the volumes of the feeds.
+type Distribution struct {
+	Unit piyolog.VolumeUnit
+	// Values are the volumes in ascending order.
+	Values []float64
+}
+
+// Min returns the smallest volume, or 0 if there are no volumes.
+func (d Distribution) Min() float64 {
+	if len(d.Values) == 0 {
+		return 0
+	}
+	return d.Values[0]
+}
+
+// Max returns the largest volume, or 0 if there are no volumes.
+func (d Distribution) Max() float64 {
+	if len(d.Values) == 0 {
+		return 0
+	}
+	return d.Values[len(d.Values)-1]
+}
+
+// Mean returns the mean of the volumes, or 0 if there are no volumes.
+func (d Distribution) Mean() float64 {
+	if len(d.Values) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range d.Values {
+		sum += v
+	}
+	return sum / float64(len(d.Values))
+}
+
+// Median returns the median of the volumes.
+func (d Distribution) Median() float64 {
+	return d.Percentile(50)
+}
+
+// Percentile returns the p-th percentile of the volumes interpolated linearly,
+// or 0 if there are no volumes.
+func (d Distribution) Percentile(p float64) float64 {
+	if len(d.Values) == 0 {
+		return 0
+	}
+	p = math.Max(0, math.Min(100, p))
+	pos := p / 100 * float64(len(d.Values)-1)
+	i := int(pos)
+	if i == len(d.Values)-1 {
+		return d.Values[i]
+	}
+	return d.Values[i] + (d.Values[i+1]-d.Values[i])*(pos-float64(i))
+}
+
+// Bucket represents the number of the volumes in the range of [Min, Max).
+type Bucket struct {
+	Min, Max float64
+	Count    int
+}
+
+// Histogram returns the buckets of the width from 0 to the largest volume.
+func (d Distribution) Histogram(width float64) []Bucket {
+	if len(d.Values) == 0 || width <= 0 {
+		return nil
+	}
+	buckets := make([]Bucket, int(d.Max()/width)+1)
+	for i := range buckets {
+		buckets[i].Min = float64(i) * width
+		buckets[i].Max = float64(i+1) * width
+	}
+	for _, v := range d.Values {
+		buckets[int(v/width)].Count++
+	}
+	return buckets
+}
@@ -0,0 +1,89 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/kaneshin/piyolog"
+)
+
+func Test_Feeding(t *testing.T) {
+	data, err := piyolog.Parse(`【ぴよログ】2023年3月
+----------
+2023/3/7(火)
+
+03:00   ミルク 90ml   
+06:00   母乳 左 10分 / 右 5分   
+06:10   ミルク 2oz   
+12:00   ミルク 100ml   
+20:00   母乳 左 10分   
+
+----------
+2023/3/8(水)
+
+02:00   ミルク 120ml   
+09:00   うんち   
+10:30   ミルク 100ml   
+----------
+`)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	loc := data.Entries[0].Date.Location()
+	at := func(day, hour, min int) time.Time {
+		return time.Date(2023, time.March, day, hour, min, 0, 0, loc)
+	}
+	ml := func(v float64) piyolog.Volume {
+		return piyolog.Volume{Value: v, Unit: piyolog.Milliliter}
+	}
+
+	r := Feeding(data, Options{})
+	days := []Day{
+		{
+			Date: at(7, 0, 0), Feeds: 5, NightFeeds: 4,
+			FormulaCount: 3, FormulaVolume: ml(249.15),
+			NursingCount: 2, NursingDuration: 25 * time.Minute,
+		},
+		{
+			Date: at(8, 0, 0), Feeds: 2, NightFeeds: 1,
+			FormulaCount: 2, FormulaVolume: ml(220),
+		},
+	}
+	opts := []cmp.Option{
+		cmpopts.EquateApprox(0, 0.01),
+		cmpopts.EquateComparable(time.Time{}),
+	}
+	if diff := cmp.Diff(days, r.Days, opts...); diff != "" {
+		t.Errorf("Days failure: %s", diff)
+	}
+	if diff := cmp.Diff(at(8, 0, 0), r.Rolling[1].Date); diff != "" {
+		t.Errorf("Rolling failure: %s", diff)
+	}
+	if r.Rolling[1].Feeds != 7 || r.Total.Feeds != 7 || r.DayFeeds != 2 {
+		t.Errorf("Rolling = %+v, Total = %+v, DayFeeds = %d", r.Rolling[1], r.Total, r.DayFeeds)
+	}
+
+	// the feedings start at 03:00, 06:00, 12:00, 20:00, 02:00 and 10:30.
+	if r.MeanInterval != 63*time.Hour/10 || r.MedianInterval != 6*time.Hour {
+		t.Errorf("MeanInterval = %s, MedianInterval = %s", r.MeanInterval, r.MedianInterval)
+	}
+	if diff := cmp.Diff(Gap{From: at(8, 2, 0), To: at(8, 10, 30)}, r.LongestGap); diff != "" {
+		t.Errorf("LongestGap failure: %s", diff)
+	}
+
+	v := r.Volumes
+	if v.Max() != 120 || v.Median() != 100 {
+		t.Errorf("Volumes = %v", v.Values)
+	}
+	want := []Bucket{{0, 50, 0}, {50, 100, 2}, {100, 150, 3}}
+	if diff := cmp.Diff(want, v.Histogram(50)); diff != "" {
+		t.Errorf("Histogram failure: %s", diff)
+	}
+
+	r = Feeding(data, Options{From: at(8, 0, 0), Unit: piyolog.FluidOunce})
+	if len(r.Days) != 1 || r.Total.FormulaVolume.Unit != piyolog.FluidOunce {
+		t.Errorf("Days = %+v", r.Days)
+	}
+}
@@ -0,0 +1,91 @@
+package piyolog
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kaneshin/piyolog/piyologutil"
+)
+
+// DailySummary represents the daily totals PiyoLog writes after the logs of an entry.
+type DailySummary struct {
+	NursingLeft      time.Duration `json:"nursing_left"`
+	NursingRight     time.Duration `json:"nursing_right"`
+	FormulaCount     int           `json:"formula_count"`
+	FormulaVolume    Volume        `json:"formula_volume"`
+	PumpedMilkCount  int           `json:"pumped_milk_count"`
+	PumpedMilkVolume Volume        `json:"pumped_milk_volume"`
+	SolidCount       int           `json:"solid_count"`
+	SleepTotal       time.Duration `json:"sleep_total"`
+	PeeCount         int           `json:"pee_count"`
+	PoopCount        int           `json:"poop_count"`
+	// Others holds the raw lines which are not recognised.
+	Others []string `json:"others,omitempty"`
+}
+
+var reTotalCount = regexp.MustCompile(`([0-9]+) ?(回|次|회|times?\(s\)|times?)`)
+
+// totalCountAndVolume returns the count and the volume interpreted by the given string,
+// such as "7回 1140ml" and "7 time(s) 1140ml".
+func totalCountAndVolume(str string) (count int, volume Volume, err error) {
+	if sm := reTotalCount.FindStringSubmatch(str); sm != nil {
+		count, err = strconv.Atoi(sm[1])
+		if err != nil {
+			return 0, Volume{}, err
+		}
+		str = strings.Replace(str, sm[0], "", 1)
+	}
+	if str = strings.TrimSpace(str); str != "" {
+		if volume, err = ParseVolume(str); err != nil {
+			return 0, Volume{}, err
+		}
+	}
+	return count, volume, nil
+}
+
+// apply sets the total represented by the given line of the results section.
+func (s *DailySummary) apply(line string) {
+	label, value, ok := strings.Cut(line, logSeparator)
+	if !ok || !s.set(label, strings.TrimSpace(value)) {
+		s.Others = append(s.Others, line)
+	}
+}
+
+const (
+	summaryNursing    = "nursing"
+	summaryFormula    = "formula"
+	summaryPumpedMilk = "pumped milk"
+	summarySolid      = "solid"
+	summarySleep      = "sleep"
+	summaryPee        = "pee"
+	summaryPoop       = "poop"
+)
+
+func (s *DailySummary) set(label, value string) bool {
+	var err error
+	switch summaryKey(label) {
+	case summaryNursing:
+		s.NursingLeft, s.NursingRight, _, err = nursingSides(value)
+	case summaryFormula:
+		s.FormulaCount, s.FormulaVolume, err = totalCountAndVolume(value)
+	case summaryPumpedMilk:
+		s.PumpedMilkCount, s.PumpedMilkVolume, err = totalCountAndVolume(value)
+	case summarySolid:
+		s.SolidCount, _, err = totalCountAndVolume(value)
+	case summarySleep:
+		dur := strings.Join(strings.Fields(value), "")
+		if !reDuration.MatchString(dur) {
+			return false
+		}
+		s.SleepTotal = piyologutil.ParseDuration(dur)
+	case summaryPee:
+		s.PeeCount, _, err = totalCountAndVolume(value)
+	case summaryPoop:
+		s.PoopCount, _, err = totalCountAndVolume(value)
+	default:
+		return false
+	}
+	return err == nil
+}
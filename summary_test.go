@@ -0,0 +1,82 @@
+package piyolog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_DailySummary(t *testing.T) {
+	tests := []struct {
+		in  []string
+		out DailySummary
+	}{
+		{
+			in: []string{
+				"母乳合計　　   左 20分 / 右 15分",
+				"ミルク合計　   7回 600ml",
+				"搾母乳合計　   2回 80ml",
+				"離乳食合計　   3回",
+				"睡眠合計　　   15時間30分",
+				"おしっこ合計   7回",
+				"うんち合計　   1回",
+			},
+			out: DailySummary{
+				NursingLeft:      time.Duration(20) * time.Minute,
+				NursingRight:     time.Duration(15) * time.Minute,
+				FormulaCount:     7,
+				FormulaVolume:    Volume{Value: 600, Unit: Milliliter},
+				PumpedMilkCount:  2,
+				PumpedMilkVolume: Volume{Value: 80, Unit: Milliliter},
+				SolidCount:       3,
+				SleepTotal:       time.Duration(15)*time.Hour + time.Duration(30)*time.Minute,
+				PeeCount:         7,
+				PoopCount:        1,
+			},
+		},
+		{
+			in: []string{
+				"Nursing total   Left 20m / Right 15m",
+				"Formula total   7 time(s) 600ml",
+				"Sleep total   15h30m",
+				"Pee total   7 time(s)",
+				"Poop total   1 time(s)",
+			},
+			out: DailySummary{
+				NursingLeft:   time.Duration(20) * time.Minute,
+				NursingRight:  time.Duration(15) * time.Minute,
+				FormulaCount:  7,
+				FormulaVolume: Volume{Value: 600, Unit: Milliliter},
+				SleepTotal:    time.Duration(15)*time.Hour + time.Duration(30)*time.Minute,
+				PeeCount:      7,
+				PoopCount:     1,
+			},
+		},
+		{
+			in: []string{
+				"お風呂合計   1回",
+				"睡眠合計　　   たくさん",
+				"ミルクをたくさん飲んだ",
+			},
+			out: DailySummary{
+				Others: []string{
+					"お風呂合計   1回",
+					"睡眠合計　　   たくさん",
+					"ミルクをたくさん飲んだ",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in[0], func(t *testing.T) {
+			var s DailySummary
+			for _, line := range tt.in {
+				s.apply(line)
+			}
+			if diff := cmp.Diff(tt.out, s); diff != "" {
+				t.Errorf("summary parse failure: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package piyolog
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// VolumeUnit represents a unit of volume.
+type VolumeUnit int
+
+const (
+	VolumeUnitUnknown VolumeUnit = iota
+	Milliliter
+	CubicCentimeter
+	FluidOunce
+)
+
+func (u VolumeUnit) String() string {
+	switch u {
+	case Milliliter:
+		return "ml"
+	case CubicCentimeter:
+		return "cc"
+	case FluidOunce:
+		return "oz"
+	}
+	return ""
+}
+
+// milliliters is the milliliters per unit.
+func (u VolumeUnit) milliliters() float64 {
+	if u == FluidOunce {
+		return 29.5735295625 // US fluid ounce
+	}
+	return 1
+}
+
+// Volume represents a volume of milk or a drink.
+type Volume struct {
+	Value float64    `json:"value"`
+	Unit  VolumeUnit `json:"unit"`
+}
+
+// ParseVolume returns the volume interpreted by the given string,
+// such as "120ml", "4.5oz" and "100cc".
+func ParseVolume(str string) (Volume, error) {
+	v, unit, err := measurementAndUnit(str)
+	if err != nil {
+		return Volume{}, fmt.Errorf("%w: no amount in %q", ErrInvalidContent, str)
+	}
+	var u VolumeUnit
+	switch strings.ToLower(unit) {
+	case "ml":
+		u = Milliliter
+	case "cc":
+		u = CubicCentimeter
+	case "oz", "fl oz", "floz":
+		u = FluidOunce
+	default:
+		return Volume{}, fmt.Errorf("%w: unknown unit in %q", ErrInvalidContent, str)
+	}
+	return Volume{Value: v, Unit: u}, nil
+}
+
+// In returns the volume converted to the unit.
+// The volume of unknown unit is returned as it is.
+func (v Volume) In(u VolumeUnit) Volume {
+	if v.Unit == VolumeUnitUnknown || u == VolumeUnitUnknown || v.Unit == u {
+		return v
+	}
+	return Volume{
+		Value: v.Value * v.Unit.milliliters() / u.milliliters(),
+		Unit:  u,
+	}
+}
+
+// Add returns the sum of the volumes in the unit of v, or the one of w if
+// the unit of v is unknown.
+func (v Volume) Add(w Volume) Volume {
+	if v.Unit == VolumeUnitUnknown {
+		return Volume{Value: v.Value + w.Value, Unit: w.Unit}
+	}
+	w = w.In(v.Unit)
+	return Volume{Value: v.Value + w.Value, Unit: v.Unit}
+}
+
+func (v Volume) String() string {
+	return formatValue(v.Value) + v.Unit.String()
+}
+
+// TemperatureUnit represents a unit of temperature.
+type TemperatureUnit int
+
+const (
+	TemperatureUnitUnknown TemperatureUnit = iota
+	Celsius
+	Fahrenheit
+)
+
+func (u TemperatureUnit) String() string {
+	switch u {
+	case Celsius:
+		return "°C"
+	case Fahrenheit:
+		return "°F"
+	}
+	return ""
+}
+
+// Temperature represents a body temperature.
+type Temperature struct {
+	Value float64         `json:"value"`
+	Unit  TemperatureUnit `json:"unit"`
+}
+
+// ParseTemperature returns the temperature interpreted by the given string,
+// such as "36.5°C" and "97.7°F".
+func ParseTemperature(str string) (Temperature, error) {
+	v, unit, err := measurementAndUnit(str)
+	if err != nil {
+		return Temperature{}, fmt.Errorf("%w: no temperature in %q", ErrInvalidContent, str)
+	}
+	var u TemperatureUnit
+	switch unit {
+	case "°C", "℃", "C":
+		u = Celsius
+	case "°F", "℉", "F":
+		u = Fahrenheit
+	default:
+		return Temperature{}, fmt.Errorf("%w: unknown unit in %q", ErrInvalidContent, str)
+	}
+	return Temperature{Value: v, Unit: u}, nil
+}
+
+// In returns the temperature converted to the unit.
+// The temperature of unknown unit is returned as it is.
+func (t Temperature) In(u TemperatureUnit) Temperature {
+	if t.Unit == TemperatureUnitUnknown || u == TemperatureUnitUnknown || t.Unit == u {
+		return t
+	}
+	if u == Fahrenheit {
+		return Temperature{Value: t.Value*9/5 + 32, Unit: u}
+	}
+	return Temperature{Value: (t.Value - 32) * 5 / 9, Unit: u}
+}
+
+func (t Temperature) String() string {
+	return formatValue(t.Value) + t.Unit.String()
+}
+
+// formatValue returns the value rounded to two decimal places.
+func formatValue(v float64) string {
+	return strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64)
+}
+
+// UnitSystem represents a system of units.
+type UnitSystem int
+
+const (
+	// Metric is the system of milliliters and degrees Celsius.
+	Metric UnitSystem = iota + 1
+	// Imperial is the system of fluid ounces and degrees Fahrenheit.
+	Imperial
+)
+
+func (s UnitSystem) units() (VolumeUnit, TemperatureUnit) {
+	if s == Imperial {
+		return FluidOunce, Fahrenheit
+	}
+	return Milliliter, Celsius
+}
+
+// Normalize converts the volumes and temperatures of the logs and the daily
+// totals to the units of the system. The contents of the logs are left as they are.
+func (d *Data) Normalize(sys UnitSystem) {
+	vu, tu := sys.units()
+	for i := range d.Entries {
+		e := &d.Entries[i]
+		for j, l := range e.Logs {
+			switch v := l.(type) {
+			case NursingLog:
+				v.Volume = v.Volume.In(vu)
+				e.Logs[j] = v
+			case FormulaLog:
+				v.Volume = v.Volume.In(vu)
+				e.Logs[j] = v
+			case PumpedMilkLog:
+				v.Volume = v.Volume.In(vu)
+				e.Logs[j] = v
+			case DrinkLog:
+				v.Volume = v.Volume.In(vu)
+				e.Logs[j] = v
+			case PumpingLog:
+				v.Volume = v.Volume.In(vu)
+				e.Logs[j] = v
+			case BodyTemperatureLog:
+				v.Temperature = v.Temperature.In(tu)
+				e.Logs[j] = v
+			}
+		}
+		e.Summary.FormulaVolume = e.Summary.FormulaVolume.In(vu)
+		e.Summary.PumpedMilkVolume = e.Summary.PumpedMilkVolume.In(vu)
+	}
+}
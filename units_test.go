@@ -0,0 +1,119 @@
+package piyolog
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func Test_ParseVolume(t *testing.T) {
+	tests := []struct {
+		in  string
+		out Volume
+		err error
+	}{
+		{in: "120ml", out: Volume{Value: 120, Unit: Milliliter}},
+		{in: "4.5oz", out: Volume{Value: 4.5, Unit: FluidOunce}},
+		{in: "1.5 oz", out: Volume{Value: 1.5, Unit: FluidOunce}},
+		{in: "100cc", out: Volume{Value: 100, Unit: CubicCentimeter}},
+		{in: "100", err: ErrInvalidContent},
+		{in: "1杯", err: ErrInvalidContent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			v, err := ParseVolume(tt.in)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ParseVolume error: %v, want %v", err, tt.err)
+			}
+			if diff := cmp.Diff(tt.out, v); diff != "" {
+				t.Errorf("ParseVolume failure: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_ParseTemperature(t *testing.T) {
+	tests := []struct {
+		in  string
+		out Temperature
+		err error
+	}{
+		{in: "36.5°C", out: Temperature{Value: 36.5, Unit: Celsius}},
+		{in: "37℃", out: Temperature{Value: 37, Unit: Celsius}},
+		{in: "98.6°F", out: Temperature{Value: 98.6, Unit: Fahrenheit}},
+		{in: "36.5", err: ErrInvalidContent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			v, err := ParseTemperature(tt.in)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ParseTemperature error: %v, want %v", err, tt.err)
+			}
+			if diff := cmp.Diff(tt.out, v); diff != "" {
+				t.Errorf("ParseTemperature failure: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_Conversion(t *testing.T) {
+	approx := cmpopts.EquateApprox(0, 0.01)
+	tests := []struct {
+		got, want any
+	}{
+		{Volume{Value: 4, Unit: FluidOunce}.In(Milliliter), Volume{Value: 118.29, Unit: Milliliter}},
+		{Volume{Value: 120, Unit: Milliliter}.In(FluidOunce), Volume{Value: 4.06, Unit: FluidOunce}},
+		{Volume{Value: 100, Unit: CubicCentimeter}.In(Milliliter), Volume{Value: 100, Unit: Milliliter}},
+		{Volume{Value: 100, Unit: Milliliter}.Add(Volume{Value: 1, Unit: FluidOunce}), Volume{Value: 129.57, Unit: Milliliter}},
+		{Volume{}.Add(Volume{Value: 1, Unit: FluidOunce}), Volume{Value: 1, Unit: FluidOunce}},
+		{Temperature{Value: 37, Unit: Celsius}.In(Fahrenheit), Temperature{Value: 98.6, Unit: Fahrenheit}},
+		{Temperature{Value: 100.4, Unit: Fahrenheit}.In(Celsius), Temperature{Value: 38, Unit: Celsius}},
+	}
+	for _, tt := range tests {
+		if diff := cmp.Diff(tt.want, tt.got, approx); diff != "" {
+			t.Errorf("conversion failure: %s", diff)
+		}
+	}
+}
+
+func Test_Normalize(t *testing.T) {
+	data, err := Parse(`【ぴよログ】2023/3/8(水)
+
+03:00   ミルク 4.5oz   
+06:00   ミルク 120ml   
+08:00   体温 99.5°F   
+
+ミルク合計　   2回 253ml
+`)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	if ds := data.Verify(); len(ds) != 0 {
+		t.Errorf("no discrepancies must be returned: %v", ds)
+	}
+
+	data.Normalize(Metric)
+	e := data.Entries[0]
+	var got []string
+	for _, l := range e.Logs {
+		switch v := l.(type) {
+		case FormulaLog:
+			got = append(got, v.Volume.String())
+		case BodyTemperatureLog:
+			got = append(got, v.Temperature.String())
+		}
+	}
+	got = append(got, e.Summary.FormulaVolume.String())
+	want := []string{"133.08ml", "120ml", "37.5°C", "253ml"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Normalize failure: %s", diff)
+	}
+
+	data.Normalize(Imperial)
+	if v := data.Entries[0].Summary.FormulaVolume; v.Unit != FluidOunce || math.Abs(v.Value-8.55) > 0.01 {
+		t.Errorf("Normalize failure: %v", v)
+	}
+}
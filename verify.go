@@ -0,0 +1,126 @@
+package piyolog
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Discrepancy represents a daily total reported by PiyoLog which differs from
+// the one computed from the logs of the entry.
+type Discrepancy struct {
+	Date     time.Time
+	Item     string // such as "formula count" and "sleep total"
+	Reported string
+	Computed string
+}
+
+func (d Discrepancy) String() string {
+	return fmt.Sprintf("%s: %s: reported %s, computed %s",
+		d.Date.Format(time.DateOnly), d.Item, d.Reported, d.Computed)
+}
+
+// Verify returns the discrepancies between the daily totals written in the
+// results section and the ones computed from the logs of every entry.
+func (d Data) Verify() []Discrepancy {
+	var ds []Discrepancy
+	for _, e := range d.Entries {
+		ds = append(ds, e.Verify()...)
+	}
+	return ds
+}
+
+// Verify returns the discrepancies between the daily totals written in the
+// results section and the ones computed from the logs.
+// Totals which are not written in the results section are not verified.
+func (e Entry) Verify() []Discrepancy {
+	computed := e.computeSummary()
+	var ds []Discrepancy
+	check := func(item string, reported, computed any) {
+		if reported != computed {
+			ds = append(ds, Discrepancy{
+				Date:     e.Date,
+				Item:     item,
+				Reported: fmt.Sprint(reported),
+				Computed: fmt.Sprint(computed),
+			})
+		}
+	}
+	// compare the volumes in the reported unit and precision, since the
+	// volumes converted from the other unit are rounded in the results.
+	checkVolume := func(item string, reported, computed Volume) {
+		_, frac, _ := strings.Cut(strconv.FormatFloat(reported.Value, 'f', -1, 64), ".")
+		pow := math.Pow10(len(frac))
+		computed = computed.In(reported.Unit)
+		check(item, reported.Value, math.Round(computed.Value*pow)/pow)
+	}
+	for _, line := range e.Results {
+		label, _, _ := strings.Cut(line, logSeparator)
+		switch summaryKey(label) {
+		case summaryNursing:
+			check("nursing left", e.Summary.NursingLeft, computed.NursingLeft)
+			check("nursing right", e.Summary.NursingRight, computed.NursingRight)
+		case summaryFormula:
+			check("formula count", e.Summary.FormulaCount, computed.FormulaCount)
+			checkVolume("formula amount", e.Summary.FormulaVolume, computed.FormulaVolume)
+		case summaryPumpedMilk:
+			check("pumped milk count", e.Summary.PumpedMilkCount, computed.PumpedMilkCount)
+			checkVolume("pumped milk amount", e.Summary.PumpedMilkVolume, computed.PumpedMilkVolume)
+		case summarySleep:
+			check("sleep total", e.Summary.SleepTotal, computed.SleepTotal)
+		case summaryPee:
+			check("pee count", e.Summary.PeeCount, computed.PeeCount)
+		case summaryPoop:
+			check("poop count", e.Summary.PoopCount, computed.PoopCount)
+		}
+	}
+	return ds
+}
+
+// computeSummary returns the daily totals computed from the logs.
+func (e Entry) computeSummary() DailySummary {
+	var (
+		s     DailySummary
+		sleep time.Time
+	)
+	start, end := e.Date, e.Date.AddDate(0, 0, 1)
+	for _, l := range e.Logs {
+		switch v := l.(type) {
+		case NursingLog:
+			s.NursingLeft += v.Left
+			s.NursingRight += v.Right
+		case FormulaLog:
+			s.FormulaCount++
+			s.FormulaVolume = s.FormulaVolume.Add(v.Volume)
+		case PumpedMilkLog:
+			s.PumpedMilkCount++
+			s.PumpedMilkVolume = s.PumpedMilkVolume.Add(v.Volume)
+		case SleepLog:
+			sleep = v.CreatedAt()
+		case WakeUpLog:
+			// the sleep may start in the previous day, so count only the time in the day.
+			from := sleep
+			if v.Duration > 0 {
+				from = v.CreatedAt().Add(-v.Duration)
+			}
+			if !from.IsZero() {
+				if from.Before(start) {
+					from = start
+				}
+				s.SleepTotal += v.CreatedAt().Sub(from)
+			}
+			sleep = time.Time{}
+		case PeeLog:
+			s.PeeCount++
+		case PoopLog:
+			s.PoopCount++
+		}
+	}
+	// the sleep without waking up lasts until the end of the day.
+	if !sleep.IsZero() {
+		s.SleepTotal += end.Sub(sleep)
+	}
+	return s
+}
@@ -0,0 +1,51 @@
+package piyolog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_Verify(t *testing.T) {
+	in := `【ぴよログ】2023/3/8(水)
+ごふあ (0歳0か月21日)
+
+02:55   起きる (3時間35分)   
+03:00   ミルク 90ml   
+03:00   おしっこ   
+03:20   寝る   
+05:50   起きる (2時間30分)   
+06:00   おしっこ   
+07:20   うんち (多め/やわらかめ)   
+08:20   母乳 左 10分 / 右 10分   
+08:45   ミルク 80ml   
+21:55   寝る   
+
+母乳合計　　   左 10分 / 右 10分
+ミルク合計　   2回 170ml
+睡眠合計　　   7時間30分
+おしっこ合計   2回
+うんち合計　   1回
+`
+	data, err := Parse(in)
+	if err != nil {
+		t.Fatalf("unexpected error returned: %v", err)
+	}
+	if ds := data.Verify(); len(ds) != 0 {
+		t.Errorf("no discrepancies must be returned: %v", ds)
+	}
+
+	// drop the log of a formula and a pee.
+	entry := data.Entries[0]
+	entry.Logs = append(entry.Logs[:1], entry.Logs[3:]...)
+	date := time.Date(2023, time.March, 8, 0, 0, 0, 0, piyoLoc)
+	want := []Discrepancy{
+		{Date: date, Item: "formula count", Reported: "2", Computed: "1"},
+		{Date: date, Item: "formula amount", Reported: "170", Computed: "80"},
+		{Date: date, Item: "pee count", Reported: "2", Computed: "1"},
+	}
+	if diff := cmp.Diff(want, entry.Verify()); diff != "" {
+		t.Errorf("%s", diff)
+	}
+}